@@ -0,0 +1,5 @@
+package common
+
+// SourceDestCheckDisabledAddress is the allowed address pair which is used to disable the source/destination check
+// of the VPC port.
+const SourceDestCheckDisabledAddress = "1.1.1.1/0"
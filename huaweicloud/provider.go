@@ -382,8 +382,10 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_apig_environments": apig.DataSourceEnvironments(),
 
+			"huaweicloud_as_activity_logs":  as.DataSourceASActivityLogs(),
 			"huaweicloud_as_configurations": as.DataSourceASConfigurations(),
 			"huaweicloud_as_groups":         as.DataSourceASGroups(),
+			"huaweicloud_as_instances":      as.DataSourceASInstances(),
 
 			"huaweicloud_availability_zones": DataSourceAvailabilityZones(),
 
@@ -394,13 +396,14 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_cbh_instances": cbh.DataSourceCbhInstances(),
 
-			"huaweicloud_cce_addon_template": cce.DataSourceCCEAddonTemplateV3(),
-			"huaweicloud_cce_cluster":        cce.DataSourceCCEClusterV3(),
-			"huaweicloud_cce_clusters":       cce.DataSourceCCEClusters(),
-			"huaweicloud_cce_node":           cce.DataSourceCCENodeV3(),
-			"huaweicloud_cce_nodes":          cce.DataSourceCCENodes(),
-			"huaweicloud_cce_node_pool":      cce.DataSourceCCENodePoolV3(),
-			"huaweicloud_cci_namespaces":     cci.DataSourceCciNamespaces(),
+			"huaweicloud_cce_addon_template":      cce.DataSourceCCEAddonTemplateV3(),
+			"huaweicloud_cce_cluster":             cce.DataSourceCCEClusterV3(),
+			"huaweicloud_cce_cluster_certificate": cce.DataSourceCCEClusterCertificate(),
+			"huaweicloud_cce_clusters":            cce.DataSourceCCEClusters(),
+			"huaweicloud_cce_node":                cce.DataSourceCCENodeV3(),
+			"huaweicloud_cce_nodes":               cce.DataSourceCCENodes(),
+			"huaweicloud_cce_node_pool":           cce.DataSourceCCENodePoolV3(),
+			"huaweicloud_cci_namespaces":          cci.DataSourceCciNamespaces(),
 
 			"huaweicloud_cdm_flavors": DataSourceCdmFlavorV1(),
 
@@ -426,6 +429,8 @@ func Provider() *schema.Provider {
 			"huaweicloud_dds_flavors":   dds.DataSourceDDSFlavorV3(),
 			"huaweicloud_dds_instances": dds.DataSourceDdsInstance(),
 
+			"huaweicloud_dds_instance_nodes": dds.DataSourceDdsInstanceNodes(),
+
 			"huaweicloud_dms_kafka_flavors":   dms.DataSourceKafkaFlavors(),
 			"huaweicloud_dms_kafka_instances": dms.DataSourceDmsKafkaInstances(),
 			"huaweicloud_dms_product":         dms.DataSourceDmsProduct(),
@@ -433,6 +438,11 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_dms_rabbitmq_flavors": dms.DataSourceRabbitMQFlavors(),
 
+			"huaweicloud_dms_rabbitmq_vhosts":                  dms.DataSourceDmsRabbitmqVhosts(),
+			"huaweicloud_dms_rabbitmq_exchanges":               dms.DataSourceDmsRabbitmqExchanges(),
+			"huaweicloud_dms_rabbitmq_queues":                  dms.DataSourceDmsRabbitmqQueues(),
+			"huaweicloud_dms_rabbitmq_exchange_queue_bindings": dms.DataSourceDmsRabbitmqExchangeQueueBindings(),
+
 			"huaweicloud_dms_rocketmq_broker":    dms.DataSourceDmsRocketMQBroker(),
 			"huaweicloud_dms_rocketmq_instances": dms.DataSourceDmsRocketMQInstances(),
 
@@ -455,6 +465,7 @@ func Provider() *schema.Provider {
 			"huaweicloud_gaussdb_mysql_flavors":                gaussdb.DataSourceGaussdbMysqlFlavors(),
 			"huaweicloud_gaussdb_mysql_instance":               gaussdb.DataSourceGaussDBMysqlInstance(),
 			"huaweicloud_gaussdb_mysql_instances":              gaussdb.DataSourceGaussDBMysqlInstances(),
+			"huaweicloud_gaussdb_mysql_nodes":                  gaussdb.DataSourceGaussDBMysqlNodes(),
 			"huaweicloud_gaussdb_redis_instance":               gaussdb.DataSourceGaussRedisInstance(),
 
 			"huaweicloud_identity_role":        iam.DataSourceIdentityRoleV3(),
@@ -512,6 +523,13 @@ func Provider() *schema.Provider {
 			"huaweicloud_rds_backups":         rds.DataSourceBackup(),
 			"huaweicloud_rds_storage_types":   rds.DataSourceStoragetype(),
 
+			"huaweicloud_rds_restore_time_ranges": rds.DataSourceRdsRestoreTimeRanges(),
+			"huaweicloud_rds_pg_accounts":         rds.DataSourceRdsPgAccounts(),
+			"huaweicloud_rds_pg_databases":        rds.DataSourceRdsPgDatabases(),
+			"huaweicloud_rds_sqlserver_accounts":  rds.DataSourceRdsSqlserverAccounts(),
+			"huaweicloud_rds_sqlserver_databases": rds.DataSourceRdsSqlserverDatabases(),
+			"huaweicloud_rds_parameters":          rds.DataSourceRdsParameters(),
+
 			"huaweicloud_rms_policy_definitions": rms.DataSourcePolicyDefinitions(),
 
 			"huaweicloud_servicestage_component_runtimes": servicestage.DataSourceComponentRuntimes(),
@@ -537,6 +555,11 @@ func Provider() *schema.Provider {
 			"huaweicloud_vpc_subnet":             vpc.DataSourceVpcSubnetV1(),
 			"huaweicloud_vpc_subnets":            vpc.DataSourceVpcSubnets(),
 			"huaweicloud_vpc_subnet_ids":         vpc.DataSourceVpcSubnetIdsV1(),
+			"huaweicloud_vpc_network_acls":       vpc.DataSourceNetworkAcls(),
+			"huaweicloud_vpc_network_interfaces": vpc.DataSourceVpcNetworkInterfaces(),
+
+			"huaweicloud_vpc_subnet_ip_availabilities": vpc.DataSourceVpcSubnetIpAvailabilities(),
+			"huaweicloud_vpc_subnet_private_ips":       vpc.DataSourceVpcSubnetPrivateIps(),
 
 			"huaweicloud_vpcep_public_services": vpcep.DataSourceVPCEPPublicServices(),
 
@@ -649,6 +672,9 @@ func Provider() *schema.Provider {
 			"huaweicloud_as_notification":     as.ResourceAsNotification(),
 			"huaweicloud_as_policy":           as.ResourceASPolicy(),
 			"huaweicloud_as_bandwidth_policy": as.ResourceASBandWidthPolicy(),
+			"huaweicloud_as_step_policy":      as.ResourceASStepPolicy(),
+
+			"huaweicloud_as_target_tracking_policy": as.ResourceASTargetTrackingPolicy(),
 
 			"huaweicloud_bms_instance": bms.ResourceBmsInstance(),
 			"huaweicloud_bcs_instance": resourceBCSInstanceV2(),
@@ -661,13 +687,17 @@ func Provider() *schema.Provider {
 			"huaweicloud_cc_connection":       cc.ResourceCloudConnection(),
 			"huaweicloud_cc_network_instance": cc.ResourceNetworkInstance(),
 
-			"huaweicloud_cce_cluster":     cce.ResourceCluster(),
-			"huaweicloud_cce_node":        cce.ResourceCCENodeV3(),
-			"huaweicloud_cce_node_attach": cce.ResourceCCENodeAttachV3(),
-			"huaweicloud_cce_addon":       cce.ResourceCCEAddonV3(),
-			"huaweicloud_cce_node_pool":   cce.ResourceCCENodePool(),
-			"huaweicloud_cce_namespace":   cce.ResourceCCENamespaceV1(),
-			"huaweicloud_cce_pvc":         cce.ResourceCcePersistentVolumeClaimsV1(),
+			"huaweicloud_cce_cluster":               cce.ResourceCluster(),
+			"huaweicloud_cce_cluster_configuration": cce.ResourceClusterConfiguration(),
+			"huaweicloud_cce_cluster_log_config":    cce.ResourceClusterLogConfig(),
+			"huaweicloud_cce_node":                  cce.ResourceCCENodeV3(),
+			"huaweicloud_cce_node_attach":           cce.ResourceCCENodeAttachV3(),
+			"huaweicloud_cce_addon":                 cce.ResourceCCEAddonV3(),
+			"huaweicloud_cce_node_pool":             cce.ResourceCCENodePool(),
+			"huaweicloud_cce_namespace":             cce.ResourceCCENamespaceV1(),
+			"huaweicloud_cce_pvc":                   cce.ResourceCcePersistentVolumeClaimsV1(),
+			"huaweicloud_cce_partition":             cce.ResourcePartition(),
+			"huaweicloud_cce_autoscaling_policy":    cce.ResourceAutoscalingPolicy(),
 
 			"huaweicloud_cts_tracker":      cts.ResourceCTSTracker(),
 			"huaweicloud_cts_data_tracker": cts.ResourceCTSDataTracker(),
@@ -702,6 +732,7 @@ func Provider() *schema.Provider {
 			"huaweicloud_compute_servergroup":      ecs.ResourceComputeServerGroup(),
 			"huaweicloud_compute_eip_associate":    ecs.ResourceComputeEIPAssociate(),
 			"huaweicloud_compute_volume_attach":    ecs.ResourceComputeVolumeAttach(),
+			"huaweicloud_compute_template":         ecs.ResourceComputeTemplate(),
 
 			"huaweicloud_cph_server": cph.ResourceCphServer(),
 
@@ -715,6 +746,9 @@ func Provider() *schema.Provider {
 			"huaweicloud_css_snapshot":  css.ResourceCssSnapshot(),
 			"huaweicloud_css_thesaurus": css.ResourceCssthesaurus(),
 
+			"huaweicloud_css_log_setting":   css.ResourceLogSetting(),
+			"huaweicloud_css_configuration": css.ResourceCssConfiguration(),
+
 			"huaweicloud_dbss_instance": dbss.ResourceInstance(),
 
 			"huaweicloud_dc_virtual_gateway":   dc.ResourceVirtualGateway(),
@@ -723,6 +757,11 @@ func Provider() *schema.Provider {
 			"huaweicloud_dcs_instance": dcs.ResourceDcsInstance(),
 			"huaweicloud_dcs_backup":   dcs.ResourceDcsBackup(),
 
+			"huaweicloud_dcs_account":         dcs.ResourceDcsAccount(),
+			"huaweicloud_dcs_parameters":      dcs.ResourceDcsParameters(),
+			"huaweicloud_dcs_bigkey_analysis": dcs.ResourceDcsBigkeyAnalysis(),
+			"huaweicloud_dcs_hotkey_analysis": dcs.ResourceDcsHotkeyAnalysis(),
+
 			"huaweicloud_dds_database_role":      dds.ResourceDatabaseRole(),
 			"huaweicloud_dds_database_user":      dds.ResourceDatabaseUser(),
 			"huaweicloud_dds_instance":           dds.ResourceDdsInstanceV3(),
@@ -759,6 +798,16 @@ func Provider() *schema.Provider {
 			"huaweicloud_dms_kafka_topic":       dms.ResourceDmsKafkaTopic(),
 			"huaweicloud_dms_rabbitmq_instance": dms.ResourceDmsRabbitmqInstance(),
 
+			"huaweicloud_dms_kafka_consumer_group":         dms.ResourceDmsKafkaConsumerGroup(),
+			"huaweicloud_dms_kafka_partition_reassignment": dms.ResourceDmsKafkaPartitionReassignment(),
+			"huaweicloud_dms_kafka_smart_connect":          dms.ResourceDmsKafkaSmartConnect(),
+			"huaweicloud_dms_kafka_smart_connect_task":     dms.ResourceDmsKafkaSmartConnectTask(),
+
+			"huaweicloud_dms_rabbitmq_vhost":                  dms.ResourceDmsRabbitmqVhost(),
+			"huaweicloud_dms_rabbitmq_exchange":               dms.ResourceDmsRabbitmqExchange(),
+			"huaweicloud_dms_rabbitmq_queue":                  dms.ResourceDmsRabbitmqQueue(),
+			"huaweicloud_dms_rabbitmq_exchange_queue_binding": dms.ResourceDmsRabbitmqExchangeQueueBinding(),
+
 			"huaweicloud_dms_rocketmq_instance":       dms.ResourceDmsRocketMQInstance(),
 			"huaweicloud_dms_rocketmq_consumer_group": dms.ResourceDmsRocketMQConsumerGroup(),
 			"huaweicloud_dms_rocketmq_topic":          dms.ResourceDmsRocketMQTopic(),
@@ -817,6 +866,8 @@ func Provider() *schema.Provider {
 			"huaweicloud_gaussdb_influx_instance":    gaussdb.ResourceGaussDBInfluxInstanceV3(),
 			"huaweicloud_gaussdb_mongo_instance":     gaussdb.ResourceGaussDBMongoInstanceV3(),
 
+			"huaweicloud_gaussdb_mysql_sql_control_rule": gaussdb.ResourceGaussDBSqlControlRule(),
+
 			"huaweicloud_ges_graph": ResourceGesGraphV1(),
 
 			"huaweicloud_hss_host_group": hss.ResourceHostGroup(),
@@ -848,6 +899,7 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_images_image":                ims.ResourceImsImage(),
 			"huaweicloud_images_image_copy":           ims.ResourceImsImageCopy(),
+			"huaweicloud_images_image_export":         ims.ResourceImsImageExport(),
 			"huaweicloud_images_image_share":          ims.ResourceImsImageShare(),
 			"huaweicloud_images_image_share_accepter": ims.ResourceImsImageShareAccepter(),
 
@@ -913,12 +965,13 @@ func Provider() *schema.Provider {
 			"huaweicloud_nat_private_snat_rule":  nat.ResourcePrivateSnatRule(),
 			"huaweicloud_nat_private_transit_ip": nat.ResourcePrivateTransitIp(),
 
-			"huaweicloud_network_acl":              ResourceNetworkACL(),
-			"huaweicloud_network_acl_rule":         ResourceNetworkACLRule(),
-			"huaweicloud_networking_secgroup":      ResourceNetworkingSecGroup(),
-			"huaweicloud_networking_secgroup_rule": ResourceNetworkingSecGroupRule(),
-			"huaweicloud_networking_vip":           vpc.ResourceNetworkingVip(),
-			"huaweicloud_networking_vip_associate": vpc.ResourceNetworkingVIPAssociateV2(),
+			"huaweicloud_network_acl":               ResourceNetworkACL(),
+			"huaweicloud_network_acl_rule":          ResourceNetworkACLRule(),
+			"huaweicloud_networking_secgroup":       ResourceNetworkingSecGroup(),
+			"huaweicloud_networking_secgroup_rule":  ResourceNetworkingSecGroupRule(),
+			"huaweicloud_networking_secgroup_rules": vpc.ResourceNetworkingSecGroupRules(),
+			"huaweicloud_networking_vip":            vpc.ResourceNetworkingVip(),
+			"huaweicloud_networking_vip_associate":  vpc.ResourceNetworkingVIPAssociateV2(),
 
 			"huaweicloud_obs_bucket":             obs.ResourceObsBucket(),
 			"huaweicloud_obs_bucket_acl":         obs.ResourceOBSBucketAcl(),
@@ -937,6 +990,17 @@ func Provider() *schema.Provider {
 			"huaweicloud_rds_read_replica_instance":    rds.ResourceRdsReadReplicaInstance(),
 			"huaweicloud_rds_backup":                   rds.ResourceBackup(),
 
+			"huaweicloud_rds_cross_region_backup_strategy": rds.ResourceRdsCrossRegionBackupStrategy(),
+			"huaweicloud_rds_sql_audit":                    rds.ResourceRdsSqlAudit(),
+
+			"huaweicloud_rds_pg_account":                   rds.ResourceRdsPgAccount(),
+			"huaweicloud_rds_pg_database":                  rds.ResourceRdsPgDatabase(),
+			"huaweicloud_rds_pg_database_privilege":        rds.ResourceRdsPgDatabasePrivilege(),
+			"huaweicloud_rds_pg_schema":                    rds.ResourceRdsPgSchema(),
+			"huaweicloud_rds_sqlserver_account":            rds.ResourceRdsSqlserverAccount(),
+			"huaweicloud_rds_sqlserver_database":           rds.ResourceRdsSqlserverDatabase(),
+			"huaweicloud_rds_sqlserver_database_privilege": rds.ResourceRdsSqlserverDatabasePrivilege(),
+
 			"huaweicloud_rms_policy_assignment": rms.ResourcePolicyAssignment(),
 
 			"huaweicloud_secmaster_incident": secmaster.ResourceIncident(),
@@ -987,6 +1051,8 @@ func Provider() *schema.Provider {
 			"huaweicloud_vpc_subnet":                      vpc.ResourceVpcSubnetV1(),
 			"huaweicloud_vpc_address_group":               vpc.ResourceVpcAddressGroup(),
 			"huaweicloud_vpc_flow_log":                    vpc.ResourceVpcFlowLog(),
+			"huaweicloud_vpc_network_acl":                 vpc.ResourceNetworkAcl(),
+			"huaweicloud_vpc_network_interface":           vpc.ResourceVpcNetworkInterface(),
 
 			"huaweicloud_vpcep_approval": vpcep.ResourceVPCEndpointApproval(),
 			"huaweicloud_vpcep_endpoint": vpcep.ResourceVPCEndpoint(),
@@ -122,6 +122,8 @@ var (
 
 	// The cluster ID of the CCE
 	HW_CCE_CLUSTER_ID = os.Getenv("HW_CCE_CLUSTER_ID")
+	// The public border group (edge zone) used to create the CCE partition
+	HW_CCE_PARTITION_AZ = os.Getenv("HW_CCE_PARTITION_AZ")
 	// The namespace of the workload is located
 	HW_WORKLOAD_NAMESPACE = os.Getenv("HW_WORKLOAD_NAMESPACE")
 	// The workload type deployed in CCE/CCI
@@ -598,6 +600,13 @@ func TestAccPreCheckCceClusterId(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckCcePartitionAz(t *testing.T) {
+	if HW_CCE_PARTITION_AZ == "" {
+		t.Skip("HW_CCE_PARTITION_AZ must be set for CCE partition acceptance tests")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckWorkloadNameSpace(t *testing.T) {
 	if HW_WORKLOAD_NAMESPACE == "" {
@@ -0,0 +1,44 @@
+package as
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceASActivityLogs_basic(t *testing.T) {
+	dataSourceName := "data.huaweicloud_as_activity_logs.logs"
+	name := acceptance.RandomAccResourceName()
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceASActivityLogs_conf(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dataSourceName, "activity_logs.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "activity_logs.0.status", "SUCCESS"),
+					resource.TestCheckResourceAttr(dataSourceName, "activity_logs.0.desire_value", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceASActivityLogs_conf(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_as_activity_logs" "logs" {
+  scaling_group_id = huaweicloud_as_group.acc_as_group.id
+}
+`, testASGroup_forceDelete(name))
+}
@@ -0,0 +1,46 @@
+package as
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceASInstances_basic(t *testing.T) {
+	dataSourceName := "data.huaweicloud_as_instances.instances"
+	name := acceptance.RandomAccResourceName()
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceASInstances_conf(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.life_cycle_state", "INSERVICE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.scaling_configuration_id",
+						"huaweicloud_as_configuration.acc_as_config", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceASInstances_conf(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_as_instances" "instances" {
+  scaling_group_id = huaweicloud_as_group.acc_as_group.id
+  life_cycle_state = "INSERVICE"
+}
+`, testASGroup_forceDelete(name))
+}
@@ -140,6 +140,39 @@ func TestAccASGroup_sourceDestCheck(t *testing.T) {
 	})
 }
 
+func TestAccASGroup_instanceRefresh(t *testing.T) {
+	var asGroup groups.Group
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_as_group.acc_as_group"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASGroup_instanceRefresh(rName, "acc_as_config"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASGroupExists(resourceName, &asGroup),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.batch_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.checkpoint_delay", "30"),
+				),
+			},
+			{
+				Config: testASGroup_instanceRefresh(rName, "acc_as_config_update"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASGroupExists(resourceName, &asGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "scaling_configuration_id",
+						"huaweicloud_as_configuration.acc_as_config_update", "id"),
+					resource.TestCheckResourceAttr(resourceName, "instances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "INSERVICE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckASGroupDestroy(s *terraform.State) error {
 	config := acceptance.TestAccProvider.Meta().(*config.Config)
 	asClient, err := config.AutoscalingV1Client(acceptance.HW_REGION_NAME)
@@ -395,3 +428,46 @@ resource "huaweicloud_as_group" "acc_as_group"{
 }
 `, testASGroup_Base(rName), rName)
 }
+
+func testASGroup_instanceRefresh(rName, configName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_as_configuration" "acc_as_config_update"{
+  scaling_configuration_name = "%[2]s_update"
+  instance_config {
+    image    = data.huaweicloud_images_image.test.id
+    flavor   = data.huaweicloud_compute_flavors.test.ids[0]
+    key_name = huaweicloud_compute_keypair.acc_key.id
+    disk {
+      size        = 50
+      volume_type = "SSD"
+      disk_type   = "SYS"
+    }
+  }
+}
+
+resource "huaweicloud_as_group" "acc_as_group"{
+  scaling_group_name       = "%[2]s"
+  scaling_configuration_id = huaweicloud_as_configuration.%[3]s.id
+  desire_instance_number   = 2
+  min_instance_number      = 2
+  max_instance_number      = 2
+  delete_instances         = "yes"
+  force_delete             = true
+  vpc_id                   = huaweicloud_vpc.test.id
+
+  networks {
+    id = huaweicloud_vpc_subnet.test.id
+  }
+  security_groups {
+    id = huaweicloud_networking_secgroup.test.id
+  }
+
+  instance_refresh {
+    batch_size       = 1
+    checkpoint_delay = 30
+  }
+}
+`, testASGroup_Base(rName), rName, configName)
+}
@@ -0,0 +1,115 @@
+package as
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/policies"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccASStepPolicy_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_as_step_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASStepPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASStepPolicy_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "comparison_operator", ">="),
+					resource.TestCheckResourceAttr(resourceName, "step_adjustment.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "step_adjustment.1.threshold", "90"),
+					resource.TestCheckResourceAttr(resourceName, "step_adjustment.1.instance_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "step_adjustment.0.status", "INSERVICE"),
+					resource.TestCheckResourceAttrSet(resourceName, "step_adjustment.0.policy_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "step_adjustment.1.alarm_id"),
+				),
+			},
+			{
+				Config: testASStepPolicy_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "step_adjustment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "step_adjustment.0.threshold", "80"),
+					resource.TestCheckResourceAttr(resourceName, "cool_down_time", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckASStepPolicyDestroy(s *terraform.State) error {
+	conf := acceptance.TestAccProvider.Meta().(*config.Config)
+	asClient, err := conf.AutoscalingV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_as_step_policy" {
+			continue
+		}
+
+		for i := 0; i < 10; i++ {
+			id := rs.Primary.Attributes[fmt.Sprintf("step_adjustment.%d.policy_id", i)]
+			if id == "" {
+				break
+			}
+			if _, err := policies.Get(asClient, id).Extract(); err == nil {
+				return fmt.Errorf("AS policy %s still exists", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testASStepPolicy_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_as_step_policy" "test" {
+  scaling_group_id    = huaweicloud_as_group.acc_as_group.id
+  scaling_policy_name = "%[2]s"
+  comparison_operator = ">="
+
+  step_adjustment {
+    threshold       = 70
+    operation       = "ADD"
+    instance_number = 1
+  }
+  step_adjustment {
+    threshold       = 90
+    operation       = "ADD"
+    instance_number = 2
+  }
+}
+`, testASPolicy_base(rName), rName)
+}
+
+func testASStepPolicy_update(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_as_step_policy" "test" {
+  scaling_group_id    = huaweicloud_as_group.acc_as_group.id
+  scaling_policy_name = "%[2]s"
+  comparison_operator = ">="
+  cool_down_time      = 600
+
+  step_adjustment {
+    threshold       = 80
+    operation       = "ADD"
+    instance_number = 1
+  }
+}
+`, testASPolicy_base(rName), rName)
+}
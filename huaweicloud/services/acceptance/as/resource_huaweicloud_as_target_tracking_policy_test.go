@@ -0,0 +1,89 @@
+package as
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/policies"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccASTargetTrackingPolicy_basic(t *testing.T) {
+	var asPolicy policies.Policy
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_as_target_tracking_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckASTargetTrackingPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testASTargetTrackingPolicy_basic(rName, 60, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASPolicyExists(resourceName, &asPolicy),
+					resource.TestCheckResourceAttr(resourceName, "scaling_policy_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "cpu_util"),
+					resource.TestCheckResourceAttr(resourceName, "target_value", "60"),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "INSERVICE"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_out_alarm_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_in_policy_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "scale_in_alarm_id"),
+				),
+			},
+			{
+				Config: testASTargetTrackingPolicy_basic(rName, 70, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckASPolicyExists(resourceName, &asPolicy),
+					resource.TestCheckResourceAttr(resourceName, "target_value", "70"),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_policy_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckASTargetTrackingPolicyDestroy(s *terraform.State) error {
+	conf := acceptance.TestAccProvider.Meta().(*config.Config)
+	asClient, err := conf.AutoscalingV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "huaweicloud_as_target_tracking_policy" {
+			continue
+		}
+
+		for _, id := range []string{rs.Primary.ID, rs.Primary.Attributes["scale_in_policy_id"]} {
+			if id == "" {
+				continue
+			}
+			if _, err := policies.Get(asClient, id).Extract(); err == nil {
+				return fmt.Errorf("AS policy %s still exists", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testASTargetTrackingPolicy_basic(rName string, targetValue int, scaleInEnabled bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_as_target_tracking_policy" "test" {
+  scaling_group_id    = huaweicloud_as_group.acc_as_group.id
+  scaling_policy_name = "%[2]s"
+  target_value        = %[3]d
+  scale_in_enabled    = %[4]t
+}
+`, testASPolicy_base(rName), rName, targetValue, scaleInEnabled)
+}
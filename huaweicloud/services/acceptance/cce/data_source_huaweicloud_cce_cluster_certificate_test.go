@@ -0,0 +1,47 @@
+package cce
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccCCEClusterCertificateDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	dataSourceName := "data.huaweicloud_cce_cluster_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCEClusterCertificateDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "host"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "client_certificate"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "client_key"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "expiry_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "kube_config_raw"),
+					resource.TestCheckResourceAttr(dataSourceName, "insecure", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCCEClusterCertificateDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_cce_cluster_certificate" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+  duration   = 7
+  context    = "internal"
+}
+`, testAccCluster_basic(rName))
+}
@@ -45,7 +45,10 @@ func TestAccCCEAddonV3_basic(t *testing.T) {
 }
 
 func TestAccCCEAddonV3_values(t *testing.T) {
-	var addon addons.Addon
+	var (
+		addon   addons.Addon
+		addonID string
+	)
 
 	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
 	resourceName := "huaweicloud_cce_addon.test"
@@ -64,6 +67,21 @@ func TestAccCCEAddonV3_values(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckCCEAddonV3Exists(resourceName, clusterName, &addon),
 					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.25.21"),
+					func(_ *terraform.State) error {
+						addonID = addon.Metadata.Id
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCCEAddonV3_valuesUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCEAddonV3Exists(resourceName, clusterName, &addon),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.25.34"),
+					// the addon is upgraded in place rather than re-installed
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &addonID),
 				),
 			},
 		},
@@ -248,3 +266,63 @@ resource "huaweicloud_cce_addon" "test" {
 }
 `, testAccCCENodePool_Base(rName), rName, acceptance.HW_PROJECT_ID)
 }
+
+func testAccCCEAddonV3_valuesUpdate(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cce_node_pool" "test" {
+  cluster_id         = huaweicloud_cce_cluster.test.id
+  name               = "%s"
+  os                 = "EulerOS 2.5"
+  flavor_id          = "c7.large.4"
+  initial_node_count = 4
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+  key_pair           = huaweicloud_compute_keypair.test.name
+  scall_enable       = true
+  min_node_count     = 2
+  max_node_count     = 10
+  priority           = 1
+  type               = "vm"
+
+  root_volume {
+    size       = 40
+    volumetype = "SSD"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SSD"
+  }
+}
+
+data "huaweicloud_cce_addon_template" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+  name       = "autoscaler"
+  version    = "1.25.34"
+}
+
+resource "huaweicloud_cce_addon" "test" {
+  cluster_id    = huaweicloud_cce_cluster.test.id
+  template_name = "autoscaler"
+  version       = "1.25.34"
+
+  values {
+    basic       = jsondecode(data.huaweicloud_cce_addon_template.test.spec).basic
+    custom_json = jsonencode(merge(
+      jsondecode(data.huaweicloud_cce_addon_template.test.spec).parameters.custom,
+      {
+        cluster_id                    = huaweicloud_cce_cluster.test.id
+        tenant_id                     = "%s"
+        scaleDownEnabled              = false
+        scaleDownUtilizationThreshold = 0.3
+      }
+    ))
+    flavor_json = jsonencode(jsondecode(data.huaweicloud_cce_addon_template.test.spec).parameters.flavor2)
+  }
+  
+  depends_on = [
+    huaweicloud_cce_node_pool.test,
+  ]
+}
+`, testAccCCENodePool_Base(rName), rName, acceptance.HW_PROJECT_ID)
+}
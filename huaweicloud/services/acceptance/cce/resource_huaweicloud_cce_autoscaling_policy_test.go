@@ -0,0 +1,152 @@
+package cce
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getAutoscalingPolicyResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("cce", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE Client: %s", err)
+	}
+
+	getPath := client.Endpoint + "api/v2/projects/{project_id}/clusters/{cluster_id}/autoscalingpolicies/{id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{cluster_id}", state.Primary.Attributes["cluster_id"])
+	getPath = strings.ReplaceAll(getPath, "{id}", state.Primary.ID)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CCE autoscaling policy: %s", err)
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccAutoscalingPolicy_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_cce_autoscaling_policy.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getAutoscalingPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoscalingPolicy_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttrPair(rName, "node_pool_ids.0", "huaweicloud_cce_node_pool.test", "id"),
+					resource.TestCheckResourceAttr(rName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(rName, "rules.0.type", "Metric"),
+					resource.TestCheckResourceAttr(rName, "rules.0.metric_value", "80"),
+					resource.TestCheckResourceAttr(rName, "expander", "priority"),
+					resource.TestCheckResourceAttr(rName, "scale_down.0.utilization_threshold", "0.5"),
+				),
+			},
+			{
+				Config: testAccAutoscalingPolicy_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(rName, "rules.1.type", "Period"),
+					resource.TestCheckResourceAttr(rName, "rules.1.schedule", "0 8 * * *"),
+					resource.TestCheckResourceAttr(rName, "scale_down.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPartitionImportStateIdFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccAutoscalingPolicy_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_autoscaling_policy" "test" {
+  cluster_id    = huaweicloud_cce_cluster.test.id
+  name          = "%[2]s"
+  node_pool_ids = [huaweicloud_cce_node_pool.test.id]
+  expander      = "priority"
+
+  rules {
+    name            = "cpu-high"
+    type            = "Metric"
+    metric_name     = "CpuAllocationRate"
+    metric_operator = ">"
+    metric_value    = "80"
+    action_value    = 1
+  }
+
+  scale_down {
+    utilization_threshold = 0.5
+    unneeded_time         = 10
+    delay_after_add       = 10
+  }
+}
+`, testAccCCENodePool_basic(name), name)
+}
+
+func testAccAutoscalingPolicy_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_autoscaling_policy" "test" {
+  cluster_id    = huaweicloud_cce_cluster.test.id
+  name          = "%[2]s"
+  node_pool_ids = [huaweicloud_cce_node_pool.test.id]
+  expander      = "priority"
+
+  rules {
+    name            = "cpu-high"
+    type            = "Metric"
+    metric_name     = "CpuAllocationRate"
+    metric_operator = ">"
+    metric_value    = "70"
+    action_value    = 2
+  }
+
+  rules {
+    name         = "morning"
+    type         = "Period"
+    schedule     = "0 8 * * *"
+    action_unit  = "Percent"
+    action_value = 20
+  }
+
+  scale_down {
+    enabled = false
+  }
+}
+`, testAccCCENodePool_basic(name), name)
+}
@@ -0,0 +1,102 @@
+package cce
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getClusterConfigurationResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("cce", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE Client: %s", err)
+	}
+
+	getPath := client.Endpoint + "api/v3/projects/{project_id}/clusters/{cluster_id}/nodepools/master/configuration"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{cluster_id}", state.Primary.ID)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CCE cluster configuration: %s", err)
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccClusterConfiguration_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_cce_cluster_configuration.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getClusterConfigurationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfiguration_basic(name, 200),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "cluster_id", "huaweicloud_cce_cluster.test", "id"),
+					resource.TestCheckResourceAttr(rName, "packages.#", "2"),
+				),
+			},
+			{
+				Config: testAccClusterConfiguration_basic(name, 300),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "packages.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterConfiguration_basic(name string, qps int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_cluster_configuration" "test" {
+  cluster_id = huaweicloud_cce_cluster.test.id
+
+  packages {
+    name = "kube-apiserver"
+
+    configurations = {
+      "default-not-ready-toleration-seconds"   = "300"
+      "default-unreachable-toleration-seconds" = "300"
+    }
+  }
+
+  packages {
+    name = "kube-controller-manager"
+
+    configurations = {
+      "kube-api-qps"   = "%[2]d"
+      "kube-api-burst" = "%[2]d"
+    }
+  }
+}
+`, testAccCluster_basic(name), qps)
+}
@@ -0,0 +1,132 @@
+package cce
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getClusterLogConfigResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("cce", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE Client: %s", err)
+	}
+
+	getPath := client.Endpoint + "api/v3/projects/{project_id}/cluster/{cluster_id}/log-configs"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{cluster_id}", state.Primary.ID)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CCE cluster log configurations: %s", err)
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccClusterLogConfig_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_cce_cluster_log_config.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getClusterLogConfigResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterLogConfig_basic(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "ttl_in_days", "7"),
+					resource.TestCheckResourceAttr(rName, "log_configs.#", "2"),
+				),
+			},
+			{
+				Config: testAccClusterLogConfig_basic(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "log_configs.#", "2"),
+				),
+			},
+			{
+				Config: testAccClusterLogConfig_lts(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "log_configs.#", "1"),
+					resource.TestCheckResourceAttrPair(rName, "log_group_id", "huaweicloud_lts_group.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "log_stream_id", "huaweicloud_lts_stream.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterLogConfig_basic(name string, auditEnabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cce_cluster_log_config" "test" {
+  cluster_id  = huaweicloud_cce_cluster.test.id
+  ttl_in_days = 7
+
+  log_configs {
+    name   = "kube-apiserver"
+    enable = true
+  }
+
+  log_configs {
+    name   = "audit"
+    enable = %t
+  }
+}
+`, testAccCluster_basic(name), auditEnabled)
+}
+
+func testAccClusterLogConfig_lts(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_lts_group" "test" {
+  group_name  = "%[2]s"
+  ttl_in_days = 7
+}
+
+resource "huaweicloud_lts_stream" "test" {
+  group_id    = huaweicloud_lts_group.test.id
+  stream_name = "%[2]s"
+}
+
+resource "huaweicloud_cce_cluster_log_config" "test" {
+  cluster_id    = huaweicloud_cce_cluster.test.id
+  ttl_in_days   = 7
+  log_group_id  = huaweicloud_lts_group.test.id
+  log_stream_id = huaweicloud_lts_stream.test.id
+
+  log_configs {
+    name   = "kube-apiserver"
+    enable = true
+  }
+}
+`, testAccCluster_basic(name), name)
+}
@@ -263,6 +263,43 @@ func TestAccCCENodePool_storage(t *testing.T) {
 	})
 }
 
+func TestAccCCENodePool_rollingUpdate(t *testing.T) {
+	var nodePool nodepools.NodePool
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_cce_node_pool.test"
+	// clusterName here is used to provide the cluster id to fetch cce node pool.
+	clusterName := "huaweicloud_cce_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckCCENodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCCENodePool_rollingUpdate(rName, "s6.large.2", "docker"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodePoolExists(resourceName, clusterName, &nodePool),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "s6.large.2"),
+					resource.TestCheckResourceAttr(resourceName, "runtime", "docker"),
+					resource.TestCheckResourceAttr(resourceName, "current_node_count", "2"),
+				),
+			},
+			{
+				Config: testAccCCENodePool_rollingUpdate(rName, "s6.xlarge.2", "containerd"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCCENodePoolExists(resourceName, clusterName, &nodePool),
+					resource.TestCheckResourceAttr(resourceName, "flavor_id", "s6.xlarge.2"),
+					resource.TestCheckResourceAttr(resourceName, "runtime", "containerd"),
+					resource.TestCheckResourceAttr(resourceName, "current_node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCCENodePoolDestroy(s *terraform.State) error {
 	config := acceptance.TestAccProvider.Meta().(*config.Config)
 	cceClient, err := config.CceV3Client(acceptance.HW_REGION_NAME)
@@ -900,3 +937,35 @@ resource "huaweicloud_cce_node_pool" "test" {
 }
 `, testAccCCENodePool_Base(rName), rName)
 }
+
+func testAccCCENodePool_rollingUpdate(rName, flavor, runtime string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cce_node_pool" "test" {
+  cluster_id         = huaweicloud_cce_cluster.test.id
+  name               = "%s"
+  os                 = "EulerOS 2.9"
+  flavor_id          = "%s"
+  runtime            = "%s"
+  initial_node_count = 2
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+  key_pair           = huaweicloud_compute_keypair.test.name
+  update_strategy    = "rolling"
+
+  rolling_update {
+    max_surge       = 1
+    max_unavailable = 0
+  }
+
+  root_volume {
+    size       = 40
+    volumetype = "SSD"
+  }
+  data_volumes {
+    size       = 100
+    volumetype = "SSD"
+  }
+}
+`, testAccCCENodePool_Base(rName), rName, flavor, runtime)
+}
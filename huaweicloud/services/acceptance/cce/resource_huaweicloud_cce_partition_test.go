@@ -0,0 +1,153 @@
+package cce
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getPartitionResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("cce", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CCE Client: %s", err)
+	}
+
+	getPath := client.Endpoint + "api/v3/projects/{project_id}/clusters/{cluster_id}/partitions/{name}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{cluster_id}", state.Primary.Attributes["cluster_id"])
+	getPath = strings.ReplaceAll(getPath, "{name}", state.Primary.ID)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CCE partition: %s", err)
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func TestAccPartition_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_cce_partition.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getPartitionResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckCcePartitionAz(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartition_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", acceptance.HW_CCE_PARTITION_AZ),
+					resource.TestCheckResourceAttr(rName, "category", "IES"),
+					resource.TestCheckResourceAttr(rName, "public_border_group", acceptance.HW_CCE_PARTITION_AZ),
+					resource.TestCheckResourceAttrPair(rName, "partition_subnet_id",
+						"huaweicloud_vpc_subnet.partition", "id"),
+					resource.TestCheckResourceAttr(rName, "container_subnet_ids.#", "1"),
+				),
+			},
+			{
+				Config: testAccPartition_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "container_subnet_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccPartitionImportStateIdFunc(rName),
+			},
+		},
+	})
+}
+
+func testAccPartitionImportStateIdFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", rName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccPartition_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpc_subnet" "partition" {
+  name              = "%[2]s-partition"
+  cidr              = "192.168.10.0/24"
+  gateway_ip        = "192.168.10.1"
+  vpc_id            = huaweicloud_vpc.test.id
+  availability_zone = "%[3]s"
+}
+
+resource "huaweicloud_vpc_subnet" "container" {
+  count = 2
+
+  name              = "%[2]s-container-${count.index}"
+  cidr              = cidrsubnet("192.168.20.0/23", 1, count.index)
+  gateway_ip        = cidrhost(cidrsubnet("192.168.20.0/23", 1, count.index), 1)
+  vpc_id            = huaweicloud_vpc.test.id
+  availability_zone = "%[3]s"
+}
+`, testAccCluster_turbo(name), name, acceptance.HW_CCE_PARTITION_AZ)
+}
+
+func testAccPartition_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_partition" "test" {
+  cluster_id           = huaweicloud_cce_cluster.test.id
+  name                 = "%[2]s"
+  category             = "IES"
+  public_border_group  = "%[2]s"
+  partition_subnet_id  = huaweicloud_vpc_subnet.partition.id
+  container_subnet_ids = [huaweicloud_vpc_subnet.container[0].ipv4_subnet_id]
+}
+`, testAccPartition_base(name), acceptance.HW_CCE_PARTITION_AZ)
+}
+
+func testAccPartition_update(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cce_partition" "test" {
+  cluster_id           = huaweicloud_cce_cluster.test.id
+  name                 = "%[2]s"
+  category             = "IES"
+  public_border_group  = "%[2]s"
+  partition_subnet_id  = huaweicloud_vpc_subnet.partition.id
+  container_subnet_ids = huaweicloud_vpc_subnet.container[*].ipv4_subnet_id
+}
+`, testAccPartition_base(name), acceptance.HW_CCE_PARTITION_AZ)
+}
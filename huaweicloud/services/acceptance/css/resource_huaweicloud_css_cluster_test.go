@@ -61,6 +61,55 @@ func TestAccCssCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
 				),
 			},
+			{
+				Config: testAccCssCluster_basic(rName, 1, 8, "bar_update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "ess_node_config.0.instance_number", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCssCluster_flavorAndUpgrade(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_css_cluster.test"
+
+	var obj cluster.ClusterDetailResponse
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCssClusterFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssCluster_flavorAndUpgrade(rName, "7.9.3", "ess.spec-4u8g"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "7.9.3"),
+					resource.TestCheckResourceAttr(resourceName, "ess_node_config.0.flavor", "ess.spec-4u8g"),
+				),
+			},
+			{
+				Config: testAccCssCluster_flavorAndUpgrade(rName, "7.9.3", "ess.spec-4u16g"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "ess_node_config.0.flavor", "ess.spec-4u16g"),
+				),
+			},
+			{
+				Config: testAccCssCluster_flavorAndUpgrade(rName, "7.10.2", "ess.spec-4u16g"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "7.10.2"),
+				),
+			},
 		},
 	})
 }
@@ -232,3 +281,29 @@ resource "huaweicloud_css_cluster" "test" {
 }
 `, testAccCssBase(rName), rName, nodeNum, isAutoRenew)
 }
+
+func testAccCssCluster_flavorAndUpgrade(rName, engineVersion, flavor string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_css_cluster" "test" {
+  name           = "%s"
+  engine_version = "%s"
+  upgrade_agency = "css_obs_agency"
+
+  ess_node_config {
+    flavor          = "%s"
+    instance_number = 3
+    volume {
+      volume_type = "HIGH"
+      size        = 40
+    }
+  }
+
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+}
+`, testAccCssBase(rName), rName, engineVersion, flavor)
+}
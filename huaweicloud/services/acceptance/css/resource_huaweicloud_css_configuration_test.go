@@ -0,0 +1,54 @@
+package css
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccCssConfiguration_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_css_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssConfiguration_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "huaweicloud_css_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.thread_pool.force_merge.size", "1"),
+				),
+			},
+			{
+				Config: testAccCssConfiguration_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parameters.thread_pool.force_merge.size", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCssConfiguration_basic(rName string, forceMergeSize int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_css_configuration" "test" {
+  cluster_id = huaweicloud_css_cluster.test.id
+
+  parameters = {
+    "thread_pool.force_merge.size" = "%d"
+  }
+}
+`, testAccCssCluster_basic(rName, 1, 7, "bar"), forceMergeSize)
+}
@@ -0,0 +1,87 @@
+package css
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getCssLogSettingFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcCssV1Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSS v1 client: %s", err)
+	}
+
+	resp, err := client.ShowGetLogSetting(&model.ShowGetLogSettingRequest{ClusterId: state.Primary.ID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.LogConfiguration == nil || resp.LogConfiguration.LogSwitch == nil || !*resp.LogConfiguration.LogSwitch {
+		return nil, fmt.Errorf("the log backup of CSS cluster (%s) is disabled", state.Primary.ID)
+	}
+	return resp, nil
+}
+
+func TestAccCssLogSetting_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_css_log_setting.test"
+
+	var obj model.ShowGetLogSettingResponse
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCssLogSettingFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssLogSetting_basic(rName, "css_log/acctest", ""),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "huaweicloud_css_cluster.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "agency", "css_obs_agency"),
+					resource.TestCheckResourceAttr(resourceName, "base_path", "css_log/acctest"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccCssLogSetting_basic(rName, "css_log/acctest_update", "00:00 GMT+08:00"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "base_path", "css_log/acctest_update"),
+					resource.TestCheckResourceAttr(resourceName, "period", "00:00 GMT+08:00"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCssLogSetting_basic(rName, basePath, period string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_css_log_setting" "test" {
+  cluster_id = huaweicloud_css_cluster.test.id
+  agency     = "css_obs_agency"
+  bucket     = huaweicloud_obs_bucket.cssObs.bucket
+  base_path  = "%s"
+  period     = "%s"
+}
+`, testAccCssCluster_basic(rName, 1, 7, "bar"), basePath, period)
+}
@@ -0,0 +1,170 @@
+package dcs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDcsAccountResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	// getAccount: Query DCS account
+	var (
+		getAccountHttpUrl = "v2/{project_id}/instances/{instance_id}/accounts"
+		getAccountProduct = "dcs"
+	)
+	getAccountClient, err := cfg.NewServiceClient(getAccountProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DCS Client: %s", err)
+	}
+
+	getAccountPath := getAccountClient.Endpoint + getAccountHttpUrl
+	getAccountPath = strings.ReplaceAll(getAccountPath, "{project_id}", getAccountClient.ProjectID)
+	getAccountPath = strings.ReplaceAll(getAccountPath, "{instance_id}", state.Primary.Attributes["instance_id"])
+
+	getAccountOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getAccountResp, err := getAccountClient.Request("GET", getAccountPath, &getAccountOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DCS account: %s", err)
+	}
+	getAccountRespBody, err := utils.FlattenResponse(getAccountResp)
+	if err != nil {
+		return nil, err
+	}
+
+	account := utils.PathSearch(fmt.Sprintf("accounts[?account_id=='%s']|[0]", state.Primary.ID),
+		getAccountRespBody, nil)
+	if account == nil || utils.PathSearch("status", account, "") == "DELETED" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return account, nil
+}
+
+func TestAccDcsAccount_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dcs_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDcsAccountResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDcsAccount_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_dcs_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "account_name", "user_test"),
+					resource.TestCheckResourceAttr(rName, "account_role", "read"),
+					resource.TestCheckResourceAttr(rName, "description", "test DCS account"),
+					resource.TestCheckResourceAttr(rName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(rName, "account_type"),
+				),
+			},
+			{
+				Config: testDcsAccount_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "account_name", "user_test"),
+					resource.TestCheckResourceAttr(rName, "account_role", "write"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "status", "AVAILABLE"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testDcsAccountImportState(rName),
+				ImportStateVerifyIgnore: []string{"account_password"},
+			},
+		},
+	})
+}
+
+func testDcsAccountImportState(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+// testDcsAccount_base creates a Redis 6.0 instance, which supports the ACL accounts.
+func testDcsAccount_base(name string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_vpc" "test" {
+  name = "vpc-default"
+}
+
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+resource "huaweicloud_dcs_instance" "test" {
+  name               = "%s"
+  engine_version     = "6.0"
+  password           = "Huawei_test"
+  engine             = "Redis"
+  capacity           = 0.125
+  vpc_id             = data.huaweicloud_vpc.test.id
+  subnet_id          = data.huaweicloud_vpc_subnet.test.id
+  availability_zones = [data.huaweicloud_availability_zones.test.names[0]]
+  flavor             = "redis.ha.xu1.tiny.r2.128"
+}
+`, name)
+}
+
+func testDcsAccount_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_account" "test" {
+  instance_id      = huaweicloud_dcs_instance.test.id
+  account_name     = "user_test"
+  account_password = "Huawei_test_123"
+  account_role     = "read"
+  description      = "test DCS account"
+}
+`, testDcsAccount_base(name))
+}
+
+func testDcsAccount_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_account" "test" {
+  instance_id      = huaweicloud_dcs_instance.test.id
+  account_name     = "user_test"
+  account_password = "Huawei_test_456"
+  account_role     = "write"
+}
+`, testDcsAccount_base(name))
+}
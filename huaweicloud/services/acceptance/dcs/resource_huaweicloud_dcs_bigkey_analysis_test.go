@@ -0,0 +1,107 @@
+package dcs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDcsBigkeyAnalysisResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	// getTask: Query DCS bigkey analysis
+	var (
+		getTaskHttpUrl = "v2/{project_id}/instances/{instance_id}/bigkey-task/{id}"
+		getTaskProduct = "dcs"
+	)
+	getTaskClient, err := cfg.NewServiceClient(getTaskProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DCS Client: %s", err)
+	}
+
+	getTaskPath := getTaskClient.Endpoint + getTaskHttpUrl
+	getTaskPath = strings.ReplaceAll(getTaskPath, "{project_id}", getTaskClient.ProjectID)
+	getTaskPath = strings.ReplaceAll(getTaskPath, "{instance_id}", state.Primary.Attributes["instance_id"])
+	getTaskPath = strings.ReplaceAll(getTaskPath, "{id}", state.Primary.ID)
+
+	getTaskOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getTaskResp, err := getTaskClient.Request("GET", getTaskPath, &getTaskOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getTaskResp)
+}
+
+func TestAccDcsBigkeyAnalysis_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dcs_bigkey_analysis.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDcsBigkeyAnalysisResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDcsBigkeyAnalysis_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_dcs_instance.instance_1", "id"),
+					resource.TestCheckResourceAttr(rName, "scan_type", "manual"),
+					resource.TestCheckResourceAttr(rName, "status", "success"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+					resource.TestCheckResourceAttrSet(rName, "started_at"),
+					resource.TestCheckResourceAttrSet(rName, "finished_at"),
+					resource.TestCheckResourceAttrSet(rName, "num"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testDcsBigkeyAnalysisImportState(rName),
+			},
+		},
+	})
+}
+
+func testDcsBigkeyAnalysisImportState(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testDcsBigkeyAnalysis_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_bigkey_analysis" "test" {
+  instance_id = huaweicloud_dcs_instance.instance_1.id
+}
+`, testAccDcsV1Instance_basic(name))
+}
@@ -0,0 +1,72 @@
+package dcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDcsParameters_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dcs_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDcsParameters_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_dcs_instance.instance_1", "id"),
+					resource.TestCheckResourceAttr(rName, "parameters.maxmemory-policy", "allkeys-lfu"),
+					resource.TestCheckResourceAttr(rName, "parameters.timeout", "100"),
+					resource.TestCheckResourceAttrSet(rName, "configuration_parameters.#"),
+				),
+			},
+			{
+				Config: testDcsParameters_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "parameters.maxmemory-policy", "volatile-lru"),
+					resource.TestCheckResourceAttr(rName, "parameters.timeout", "200"),
+					resource.TestCheckResourceAttr(rName, "parameters.notify-keyspace-events", "Ex"),
+				),
+			},
+		},
+	})
+}
+
+func testDcsParameters_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_parameters" "test" {
+  instance_id = huaweicloud_dcs_instance.instance_1.id
+
+  parameters = {
+    "maxmemory-policy" = "allkeys-lfu"
+    "timeout"          = "100"
+  }
+}
+`, testAccDcsV1Instance_basic(name))
+}
+
+func testDcsParameters_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_parameters" "test" {
+  instance_id                 = huaweicloud_dcs_instance.instance_1.id
+  restart_on_parameter_change = true
+
+  parameters = {
+    "maxmemory-policy"       = "volatile-lru"
+    "timeout"                = "200"
+    "notify-keyspace-events" = "Ex"
+  }
+}
+`, testAccDcsV1Instance_basic(name))
+}
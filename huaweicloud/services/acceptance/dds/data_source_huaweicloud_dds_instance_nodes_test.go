@@ -0,0 +1,45 @@
+package dds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceDdsInstanceNodes_basic(t *testing.T) {
+	rName := "data.huaweicloud_dds_instance_nodes.test"
+	name := acceptance.RandomAccResourceName()
+	dc := acceptance.InitDataSourceCheck(rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDdsInstanceNodes_basic(name, 8800),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(rName, "nodes.0.type", "mongos"),
+					resource.TestCheckResourceAttrSet(rName, "nodes.0.id"),
+					resource.TestCheckResourceAttrSet(rName, "nodes.0.private_ip"),
+					resource.TestCheckResourceAttrSet(rName, "nodes.0.private_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDdsInstanceNodes_basic(name string, port int) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_dds_instance_nodes" "test" {
+  instance_id = huaweicloud_dds_instance.test.id
+  type        = "mongos"
+}
+`, testAccDatasourceDdsInstance_base(name, port))
+}
@@ -110,6 +110,15 @@ func TestAccDDSV3Instance_basic(t *testing.T) {
 					testAccCheckDDSV3InstanceFlavor(&instance, "mongos", "spec_code", "dds.mongodb.s6.large.4.mongos"),
 				),
 			},
+			{
+				Config: testAccDDSInstanceV3Config_reduceFlavorNum(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					testAccCheckDDSV3InstanceFlavor(&instance, "shard", "num", 2),
+					testAccCheckDDSV3InstanceFlavor(&instance, "mongos", "num", 3),
+				),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -230,7 +239,10 @@ func TestAccDDSV3Instance_withConfigurationSharding(t *testing.T) {
 }
 
 func TestAccDDSV3Instance_withConfigurationReplicaSet(t *testing.T) {
-	var instance instances.InstanceResponse
+	var (
+		instance   instances.InstanceResponse
+		instanceID string
+	)
 	rName := acceptance.RandomAccResourceName()
 	resourceName := "huaweicloud_dds_instance.instance"
 
@@ -256,6 +268,22 @@ func TestAccDDSV3Instance_withConfigurationReplicaSet(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.owner", "terraform"),
 					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.start_time", "08:00-09:00"),
 					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "8"),
+					func(_ *terraform.State) error {
+						instanceID = instance.Id
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccDDSInstanceV3Config_withReplicaSetConfigurationUpdate(rName, 8900),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &instanceID),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.id",
+						"huaweicloud_dds_parameter_template.replica_update", "id"),
+					resource.TestCheckResourceAttr(resourceName, "readonly_node.0.num", "1"),
+					resource.TestCheckResourceAttr(resourceName, "readonly_node.0.spec_code",
+						"dds.mongodb.s6.large.2.readonly"),
 				),
 			},
 		},
@@ -622,6 +650,59 @@ resource "huaweicloud_dds_instance" "instance" {
 }`, common.TestBaseNetwork(rName), rName)
 }
 
+func testAccDDSInstanceV3Config_reduceFlavorNum(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_dds_instance" "instance" {
+  name              = "%s"
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  vpc_id            = huaweicloud_vpc.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  password          = "Terraform@123"
+  mode              = "Sharding"
+
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+
+  flavor {
+    type      = "mongos"
+    num       = 3
+    spec_code = "dds.mongodb.s6.large.4.mongos"
+  }
+  flavor {
+    type      = "shard"
+    num       = 2
+    storage   = "ULTRAHIGH"
+    size      = 30
+    spec_code = "dds.mongodb.s6.large.2.shard"
+  }
+  flavor {
+    type      = "config"
+    num       = 1
+    storage   = "ULTRAHIGH"
+    size      = 20
+    spec_code = "dds.mongodb.s6.large.2.config"
+  }
+
+  backup_strategy {
+    start_time = "08:00-09:00"
+    keep_days  = "8"
+  }
+
+  tags = {
+    foo   = "bar"
+    owner = "terraform"
+  }
+}`, common.TestBaseNetwork(rName), rName)
+}
+
 func testAccDDSInstanceV3Config_withEpsId(rName string) string {
 	return fmt.Sprintf(`
 %s
@@ -922,6 +1003,71 @@ resource "huaweicloud_dds_instance" "instance" {
 }`, common.TestBaseNetwork(rName), rName, port)
 }
 
+func testAccDDSInstanceV3Config_withReplicaSetConfigurationUpdate(rName string, port int) string {
+	return fmt.Sprintf(`
+%[1]s
+data "huaweicloud_availability_zones" "test" {}
+resource "huaweicloud_dds_parameter_template" "replica" {
+  name         = "%[2]s_replica"
+  description  = "test description"
+  node_type    = "replica"
+  node_version = "3.4"
+  parameter_values = {
+    connPoolMaxConnsPerHost        = 400
+    connPoolMaxShardedConnsPerHost = 400
+  }
+}
+resource "huaweicloud_dds_parameter_template" "replica_update" {
+  name         = "%[2]s_replica_update"
+  description  = "test description"
+  node_type    = "replica"
+  node_version = "3.4"
+  parameter_values = {
+    connPoolMaxConnsPerHost        = 500
+    connPoolMaxShardedConnsPerHost = 500
+  }
+}
+resource "huaweicloud_dds_instance" "instance" {
+  name              = "%[2]s"
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  vpc_id            = huaweicloud_vpc.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  password          = "Terraform@123"
+  mode              = "ReplicaSet"
+  port              = %[3]d
+  datastore {
+    type           = "DDS-Community"
+    version        = "3.4"
+    storage_engine = "wiredTiger"
+  }
+  configuration {
+    type = "replica"
+    id   = huaweicloud_dds_parameter_template.replica_update.id
+  }
+  restart_on_parameter_change = true
+  flavor {
+    type      = "replica"
+    storage   = "ULTRAHIGH"
+    num       = 1
+    size      = 20
+    spec_code = "dds.mongodb.s6.large.2.repset"
+  }
+  readonly_node {
+    num       = 1
+    spec_code = "dds.mongodb.s6.large.2.readonly"
+  }
+  backup_strategy {
+    start_time = "08:00-09:00"
+    keep_days  = "8"
+  }
+  tags = {
+    foo   = "bar"
+    owner = "terraform"
+  }
+}`, common.TestBaseNetwork(rName), rName, port)
+}
+
 func testAccDDSInstanceV3Config_withSingleConfiguration(rName string, port int) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,47 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceDmsRabbitmqExchangeQueueBindings_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_dms_rabbitmq_exchange_queue_bindings.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDmsRabbitmqExchangeQueueBindings_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "bindings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "bindings.0.destination_type", "Queue"),
+					resource.TestCheckResourceAttr(dataSourceName, "bindings.0.destination", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "bindings.0.routing_key", "key_1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "bindings.0.properties_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDmsRabbitmqExchangeQueueBindings_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_dms_rabbitmq_exchange_queue_bindings" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost       = huaweicloud_dms_rabbitmq_vhost.test.name
+  exchange    = huaweicloud_dms_rabbitmq_exchange.test.name
+  destination = huaweicloud_dms_rabbitmq_exchange_queue_binding.test.destination
+}
+`, testAccDmsRabbitmqExchangeQueueBinding_basic(rName))
+}
@@ -0,0 +1,46 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceDmsRabbitmqExchanges_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_dms_rabbitmq_exchanges.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDmsRabbitmqExchanges_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "exchanges.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "exchanges.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "exchanges.0.type", "direct"),
+					resource.TestCheckResourceAttr(dataSourceName, "exchanges.0.durable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDmsRabbitmqExchanges_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_dms_rabbitmq_exchanges" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost       = huaweicloud_dms_rabbitmq_vhost.test.name
+  name        = huaweicloud_dms_rabbitmq_exchange.test.name
+  type        = "direct"
+}
+`, testAccDmsRabbitmqExchange_basic(rName))
+}
@@ -0,0 +1,45 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceDmsRabbitmqQueues_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_dms_rabbitmq_queues.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDmsRabbitmqQueues_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "queues.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "queues.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "queues.0.durable", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "queues.0.consumers", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDmsRabbitmqQueues_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_dms_rabbitmq_queues" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost       = huaweicloud_dms_rabbitmq_vhost.test.name
+  name        = huaweicloud_dms_rabbitmq_queue.test.name
+}
+`, testAccDmsRabbitmqQueue_basic(rName))
+}
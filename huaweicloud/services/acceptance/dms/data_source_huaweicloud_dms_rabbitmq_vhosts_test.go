@@ -0,0 +1,43 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceDmsRabbitmqVhosts_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_dms_rabbitmq_vhosts.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceDmsRabbitmqVhosts_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "vhosts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vhosts.0.name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vhosts.0.tracing"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceDmsRabbitmqVhosts_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_dms_rabbitmq_vhosts" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  name        = huaweicloud_dms_rabbitmq_vhost.test.name
+}
+`, testAccDmsRabbitmqVhost_basic(rName))
+}
@@ -0,0 +1,54 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDmsKafkaPartitionReassignment_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_dms_kafka_partition_reassignment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaPartitionReassignment_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"huaweicloud_dms_kafka_instance.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaPartitionReassignment_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_kafka_partition_reassignment" "test" {
+  instance_id = huaweicloud_dms_kafka_instance.test.id
+  throttle    = 10485760
+
+  reassignments {
+    topic = huaweicloud_dms_kafka_topic.topic.name
+
+    assignment {
+      partition         = 0
+      partition_brokers = [1, 2, 0]
+    }
+    assignment {
+      partition         = 1
+      partition_brokers = [2, 0, 1]
+    }
+  }
+}
+`, testAccDmsKafkaTopic_basic(rName))
+}
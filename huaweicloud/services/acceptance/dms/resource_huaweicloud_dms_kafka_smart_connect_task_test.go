@@ -0,0 +1,150 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDmsKafkaSmartConnectTaskFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	// getSmartConnectTask: query the Smart Connect task of the kafka instance
+	var (
+		getSmartConnectTaskHttpUrl = "v2/{project_id}/instances/{instance_id}/connector/tasks/{task_id}"
+		getSmartConnectTaskProduct = "dmsv2"
+	)
+	getSmartConnectTaskClient, err := cfg.NewServiceClient(getSmartConnectTaskProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS Client: %s", err)
+	}
+
+	getSmartConnectTaskPath := getSmartConnectTaskClient.Endpoint + getSmartConnectTaskHttpUrl
+	getSmartConnectTaskPath = strings.ReplaceAll(getSmartConnectTaskPath, "{project_id}",
+		getSmartConnectTaskClient.ProjectID)
+	getSmartConnectTaskPath = strings.ReplaceAll(getSmartConnectTaskPath, "{instance_id}",
+		state.Primary.Attributes["instance_id"])
+	getSmartConnectTaskPath = strings.ReplaceAll(getSmartConnectTaskPath, "{task_id}", state.Primary.ID)
+
+	getSmartConnectTaskOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getSmartConnectTaskResp, err := getSmartConnectTaskClient.Request("GET", getSmartConnectTaskPath,
+		&getSmartConnectTaskOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getSmartConnectTaskResp)
+}
+
+func TestAccDmsKafkaSmartConnectTask_obs(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_dms_kafka_smart_connect_task.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsKafkaSmartConnectTaskFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaSmartConnectTask_obs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "task_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "OBS_SINK"),
+					resource.TestCheckResourceAttr(resourceName, "topics.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_task.0.obs_bucket_name",
+						"huaweicloud_obs_bucket.test", "bucket"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccDmsKafkaSmartConnectTaskImportStateFunc(resourceName),
+				ImportStateVerifyIgnore: []string{
+					"start_later", "destination_task.0.access_key", "destination_task.0.secret_key",
+				},
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaSmartConnectTaskImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("DMS kafka Smart Connect task not found")
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDmsKafkaSmartConnectTask_obs(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%[2]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_dms_kafka_smart_connect_task" "test" {
+  instance_id      = huaweicloud_dms_kafka_instance.test.id
+  task_name        = "%[2]s"
+  topics           = [huaweicloud_dms_kafka_topic.topic.name]
+  destination_type = "OBS_SINK"
+
+  destination_task {
+    obs_bucket_name       = huaweicloud_obs_bucket.test.bucket
+    access_key            = "%[3]s"
+    secret_key            = "%[4]s"
+    consumer_strategy     = "earliest"
+    deliver_time_interval = 300
+    obs_path              = "dump"
+    partition_format      = "yyyy/MM/dd/HH/mm"
+    record_delimiter      = ";"
+    store_keys            = false
+  }
+
+  depends_on = [huaweicloud_dms_kafka_smart_connect.test]
+}
+`, testAccDmsKafkaSmartConnectTask_base(rName), rName, acceptance.HW_ACCESS_KEY, acceptance.HW_SECRET_KEY)
+}
+
+func testAccDmsKafkaSmartConnectTask_base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_kafka_smart_connect" "test" {
+  instance_id       = huaweicloud_dms_kafka_instance.test.id
+  storage_spec_code = "dms.physical.storage.high.v2"
+  node_count        = 2
+}
+`, testAccDmsKafkaTopic_basic(rName))
+}
@@ -0,0 +1,91 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dms/v2/kafka/instances"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getDmsKafkaSmartConnectFunc(c *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := c.DmsV2Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	instance, err := instances.Get(client, state.Primary.Attributes["instance_id"]).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if !instance.ConnectorEnalbe || instance.ConnectorID != state.Primary.ID {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return instance, nil
+}
+
+func TestAccDmsKafkaSmartConnect_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_dms_kafka_smart_connect.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsKafkaSmartConnectFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaSmartConnect_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"huaweicloud_dms_kafka_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccDmsKafkaSmartConnectImportStateFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"storage_spec_code", "bandwidth", "node_count"},
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaSmartConnectImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("DMS kafka Smart Connect not found")
+		}
+		return rs.Primary.Attributes["instance_id"], nil
+	}
+}
+
+func testAccDmsKafkaSmartConnect_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_kafka_smart_connect" "test" {
+  instance_id       = huaweicloud_dms_kafka_instance.test.id
+  storage_spec_code = "dms.physical.storage.high.v2"
+  bandwidth         = "100MB"
+  node_count        = 2
+}
+`, testAccKafkaInstance_basic(rName))
+}
@@ -0,0 +1,107 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDmsRabbitmqExchangeQueueBindingFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("dmsv2", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS Client: %s", err)
+	}
+
+	attributes := state.Primary.Attributes
+	getBindingPath := client.Endpoint + "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/" +
+		"exchanges/{exchange}/binds?limit=100&offset=0"
+	getBindingPath = strings.ReplaceAll(getBindingPath, "{project_id}", client.ProjectID)
+	getBindingPath = strings.ReplaceAll(getBindingPath, "{instance_id}", attributes["instance_id"])
+	getBindingPath = strings.ReplaceAll(getBindingPath, "{vhost}", attributes["vhost"])
+	getBindingPath = strings.ReplaceAll(getBindingPath, "{exchange}", attributes["exchange"])
+
+	getBindingOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getBindingResp, err := client.Request("GET", getBindingPath, &getBindingOpt)
+	if err != nil {
+		return nil, err
+	}
+	getBindingRespBody, err := utils.FlattenResponse(getBindingResp)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("items[?destination_type=='%s'&&destination=='%s'&&routing_key=='%s']|[0]",
+		attributes["destination_type"], attributes["destination"], attributes["routing_key"])
+	binding := utils.PathSearch(expression, getBindingRespBody, nil)
+	if binding == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return binding, nil
+}
+
+func TestAccDmsRabbitmqExchangeQueueBinding_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dms_rabbitmq_exchange_queue_binding.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRabbitmqExchangeQueueBindingFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqExchangeQueueBinding_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "exchange",
+						"huaweicloud_dms_rabbitmq_exchange.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination",
+						"huaweicloud_dms_rabbitmq_queue.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "destination_type", "Queue"),
+					resource.TestCheckResourceAttr(resourceName, "routing_key", "key_1"),
+					resource.TestCheckResourceAttrSet(resourceName, "properties_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqExchangeQueueBinding_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_exchange_queue_binding" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost       = huaweicloud_dms_rabbitmq_vhost.test.name
+  exchange    = huaweicloud_dms_rabbitmq_exchange.test.name
+  destination = huaweicloud_dms_rabbitmq_queue.test.name
+  routing_key = "key_1"
+}
+`, testAccDmsRabbitmqQueue_basic(rName))
+}
@@ -0,0 +1,70 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getDmsRabbitmqExchangeFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsRabbitmqManagementItem(cfg,
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges",
+		state.Primary.Attributes["instance_id"], state.Primary.Attributes["vhost"], state.Primary.Attributes["name"])
+}
+
+func TestAccDmsRabbitmqExchange_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dms_rabbitmq_exchange.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRabbitmqExchangeFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqExchange_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "vhost",
+						"huaweicloud_dms_rabbitmq_vhost.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "direct"),
+					resource.TestCheckResourceAttr(resourceName, "auto_delete", "false"),
+					resource.TestCheckResourceAttr(resourceName, "durable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "internal", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqExchange_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_exchange" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost       = huaweicloud_dms_rabbitmq_vhost.test.name
+  name        = "%s"
+  type        = "direct"
+}
+`, testAccDmsRabbitmqVhost_basic(rName), rName)
+}
@@ -0,0 +1,75 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getDmsRabbitmqQueueFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsRabbitmqManagementItem(cfg,
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/queues",
+		state.Primary.Attributes["instance_id"], state.Primary.Attributes["vhost"], state.Primary.Attributes["name"])
+}
+
+func TestAccDmsRabbitmqQueue_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dms_rabbitmq_queue.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRabbitmqQueueFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqQueue_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "durable", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "dead_letter_exchange",
+						"huaweicloud_dms_rabbitmq_exchange.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "dead_letter_routing_key", "dead"),
+					resource.TestCheckResourceAttr(resourceName, "message_ttl", "60000"),
+					resource.TestCheckResourceAttr(resourceName, "lazy_mode", "lazy"),
+					resource.TestCheckResourceAttr(resourceName, "messages", "0"),
+					resource.TestCheckResourceAttr(resourceName, "consumers", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqQueue_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_queue" "test" {
+  instance_id             = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost                   = huaweicloud_dms_rabbitmq_vhost.test.name
+  name                    = "%s"
+  dead_letter_exchange    = huaweicloud_dms_rabbitmq_exchange.test.name
+  dead_letter_routing_key = "dead"
+  message_ttl             = 60000
+  lazy_mode               = "lazy"
+}
+`, testAccDmsRabbitmqExchange_basic(rName), rName)
+}
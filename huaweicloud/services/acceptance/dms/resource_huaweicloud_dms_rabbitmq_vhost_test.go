@@ -0,0 +1,102 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getDmsRabbitmqManagementItem queries the item with the specified name from the RabbitMQ management list API.
+func getDmsRabbitmqManagementItem(cfg *config.Config, httpUrl, instanceID, vhost, name string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("dmsv2", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS Client: %s", err)
+	}
+
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceID)
+	path = strings.ReplaceAll(path, "{vhost}", strings.ReplaceAll(vhost, "/", "__F_SLASH__"))
+
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	resp, err := client.Request("GET", path+"?limit=100&offset=0", &opt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	item := utils.PathSearch(fmt.Sprintf("items[?name=='%s']|[0]", name), respBody, nil)
+	if item == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return item, nil
+}
+
+func getDmsRabbitmqVhostFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsRabbitmqManagementItem(cfg, "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts",
+		state.Primary.Attributes["instance_id"], "", state.Primary.Attributes["name"])
+}
+
+func TestAccDmsRabbitmqVhost_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dms_rabbitmq_vhost.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRabbitmqVhostFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqVhost_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"huaweicloud_dms_rabbitmq_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "tracing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqVhost_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_vhost" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  name        = "%s"
+}
+`, testAccDmsRabbitmqInstance_basic(rName), rName)
+}
@@ -211,6 +211,45 @@ func TestAccComputeInstance_powerAction(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_reinstallOS(t *testing.T) {
+	var instance, reinstalled cloudservers.CloudServer
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_compute_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_reinstallOS(rName, "test", "Ubuntu 18.04 server 64bit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "image_change_strategy", "reinstall"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "data.huaweicloud_images_image.test", "id"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_reinstallOS(rName, "update", "Ubuntu 18.04 server 64bit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &reinstalled),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &instance.ID),
+				),
+			},
+			{
+				Config: testAccComputeInstance_reinstallOS(rName, "update", "CentOS 7.6 64bit"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &reinstalled),
+					resource.TestCheckResourceAttrPtr(resourceName, "id", &instance.ID),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "data.huaweicloud_images_image.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccComputeInstance_disk_encryption(t *testing.T) {
 	var instance cloudservers.CloudServer
 
@@ -532,6 +571,52 @@ resource "huaweicloud_compute_instance" "test" {
 `, testAccCompute_data, rName, powerAction)
 }
 
+func testAccComputeInstance_reinstallOS(rName, userData, imageName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_compute_flavors" "test" {
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+data "huaweicloud_images_image" "test" {
+  name        = "%[3]s"
+  most_recent = true
+}
+
+data "huaweicloud_networking_secgroup" "test" {
+  name = "default"
+}
+
+resource "huaweicloud_compute_instance" "test" {
+  name                  = "%[1]s"
+  image_id              = data.huaweicloud_images_image.test.id
+  flavor_id             = data.huaweicloud_compute_flavors.test.ids[0]
+  security_group_ids    = [data.huaweicloud_networking_secgroup.test.id]
+  availability_zone     = data.huaweicloud_availability_zones.test.names[0]
+  admin_pass            = "Test@123456"
+  user_data             = "#!/bin/bash\necho %[2]s > /tmp/terraform"
+  image_change_strategy = "reinstall"
+
+  network {
+    uuid = data.huaweicloud_vpc_subnet.test.id
+  }
+
+  data_disks {
+    type = "SAS"
+    size = "10"
+  }
+}
+`, rName, userData, imageName)
+}
+
 func testAccComputeInstance_disk_encryption(rName string) string {
 	return fmt.Sprintf(`
 %s
@@ -43,6 +43,33 @@ func TestAccComputeInterfaceAttach_Basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeInterfaceAttach_networkInterface(t *testing.T) {
+	var ai attachinterfaces.Interface
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_compute_interface_attach.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeInterfaceAttachDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInterfaceAttach_networkInterface(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInterfaceAttachExists(resourceName, &ai),
+					testAccCheckComputeInterfaceAttachIP(&ai, "192.168.0.200"),
+					resource.TestCheckResourceAttrPair(resourceName, "port_id",
+						"huaweicloud_vpc_network_interface.test", "id"),
+					// the security groups of the network interface are not changed by the attachment
+					resource.TestCheckResourceAttr(resourceName, "source_dest_check", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_ids.0",
+						"huaweicloud_networking_secgroup.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func computeInterfaceAttachParseID(id string) (instanceID, portID string, err error) {
 	idParts := strings.Split(id, "/")
 	if len(idParts) < 2 {
@@ -195,3 +222,65 @@ resource "huaweicloud_compute_interface_attach" "test" {
 }
 `, rName)
 }
+
+func testAccComputeInterfaceAttach_networkInterface(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  vpc_id     = huaweicloud_vpc.test.id
+  name       = "%[1]s"
+  cidr       = cidrsubnet(huaweicloud_vpc.test.cidr, 4, 0)
+  gateway_ip = cidrhost(cidrsubnet(huaweicloud_vpc.test.cidr, 4, 0), 1)
+}
+
+resource "huaweicloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+
+data "huaweicloud_compute_flavors" "test" {
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+data "huaweicloud_images_images" "test" {
+  flavor_id = data.huaweicloud_compute_flavors.test.ids[0]
+
+  os         = "Ubuntu"
+  visibility = "public"
+}
+
+resource "huaweicloud_compute_instance" "test" {
+  name               = "%[1]s"
+  image_id           = data.huaweicloud_images_images.test.images[0].id
+  flavor_id          = data.huaweicloud_compute_flavors.test.ids[0]
+  security_group_ids = [huaweicloud_networking_secgroup.test.id]
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+  system_disk_type   = "SSD"
+
+  network {
+    uuid = huaweicloud_vpc_subnet.test.id
+  }
+}
+
+resource "huaweicloud_vpc_network_interface" "test" {
+  name               = "%[1]s"
+  subnet_id          = huaweicloud_vpc_subnet.test.id
+  fixed_ip           = cidrhost(cidrsubnet(huaweicloud_vpc.test.cidr, 4, 0), 200)
+  security_group_ids = [huaweicloud_networking_secgroup.test.id]
+  source_dest_check  = false
+}
+
+resource "huaweicloud_compute_interface_attach" "test" {
+  instance_id = huaweicloud_compute_instance.test.id
+  port_id     = huaweicloud_vpc_network_interface.test.id
+}
+`, rName)
+}
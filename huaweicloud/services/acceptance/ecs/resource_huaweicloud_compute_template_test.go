@@ -0,0 +1,190 @@
+package ecs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ecs"
+)
+
+func getComputeTemplateResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("ecs", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ECS client: %s", err)
+	}
+	return ecs.GetComputeTemplate(client, state.Primary.ID)
+}
+
+func TestAccComputeTemplate_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_compute_template.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getComputeTemplateResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeTemplate_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "initial version"),
+					resource.TestCheckResourceAttrPair(resourceName, "flavor_id",
+						"data.huaweicloud_compute_flavors.test", "ids.0"),
+					resource.TestCheckResourceAttr(resourceName, "system_disk_type", "SAS"),
+					resource.TestCheckResourceAttr(resourceName, "system_disk_size", "40"),
+					resource.TestCheckResourceAttr(resourceName, "network.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccComputeTemplate_update(rName, "update system disk"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "update system disk"),
+					resource.TestCheckResourceAttr(resourceName, "system_disk_size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "data_disks.#", "1"),
+				),
+			},
+			{
+				Config: testAccComputeTemplate_update(rName, "update description only"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "update description only"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"version_description",
+				},
+			},
+		},
+	})
+}
+
+func TestAccComputeInstance_fromTemplate(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_compute_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		"huaweicloud_compute_template.test",
+		&obj,
+		getComputeTemplateResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_fromTemplate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "template_id",
+						"huaweicloud_compute_template.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "template_version", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "flavor_id",
+						"data.huaweicloud_compute_flavors.test", "ids.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id",
+						"data.huaweicloud_images_image.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network.0.uuid",
+						"data.huaweicloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "system_disk_size", "40"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComputeTemplate_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_compute_template" "test" {
+  name                = "%s"
+  description         = "terraform test"
+  version_description = "initial version"
+  flavor_id           = data.huaweicloud_compute_flavors.test.ids[0]
+  image_id            = data.huaweicloud_images_image.test.id
+  availability_zone   = data.huaweicloud_availability_zones.test.names[0]
+  security_group_ids  = [data.huaweicloud_networking_secgroup.test.id]
+  system_disk_type    = "SAS"
+  system_disk_size    = 40
+
+  network {
+    uuid = data.huaweicloud_vpc_subnet.test.id
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccCompute_data, rName)
+}
+
+func testAccComputeTemplate_update(rName, versionDescription string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_compute_template" "test" {
+  name                = "%s"
+  description         = "terraform test"
+  version_description = "%s"
+  flavor_id           = data.huaweicloud_compute_flavors.test.ids[0]
+  image_id            = data.huaweicloud_images_image.test.id
+  availability_zone   = data.huaweicloud_availability_zones.test.names[0]
+  security_group_ids  = [data.huaweicloud_networking_secgroup.test.id]
+  system_disk_type    = "SAS"
+  system_disk_size    = 50
+
+  network {
+    uuid = data.huaweicloud_vpc_subnet.test.id
+  }
+
+  data_disks {
+    type = "SAS"
+    size = 10
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccCompute_data, rName, versionDescription)
+}
+
+func testAccComputeInstance_fromTemplate(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_compute_instance" "test" {
+  name        = "%s"
+  template_id = huaweicloud_compute_template.test.id
+}
+`, testAccComputeTemplate_basic(rName), rName)
+}
@@ -133,6 +133,59 @@ func TestAccEvsVolume_withEpsId(t *testing.T) {
 	})
 }
 
+func TestAccEvsVolume_provisionedPerformance(t *testing.T) {
+	var volume cloudvolumes.Volume
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_evs_volume.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&volume,
+		getVolumeResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvsVolume_provisionedPerformance(rName, "GPSSD2", 3000, 125),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "GPSSD2"),
+					resource.TestCheckResourceAttr(resourceName, "iops", "3000"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "125"),
+				),
+			},
+			{
+				Config: testAccEvsVolume_provisionedPerformance(rName, "GPSSD2", 5000, 250),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "iops", "5000"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "250"),
+				),
+			},
+			{
+				Config: testAccEvsVolume_retype(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "ESSD2"),
+					resource.TestCheckResourceAttr(resourceName, "iops", "10000"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"cascade",
+				},
+			},
+		},
+	})
+}
+
 func TestAccEvsVolume_prePaid(t *testing.T) {
 	var volume cloudvolumes.Volume
 	rName := acceptance.RandomAccResourceName()
@@ -265,6 +318,35 @@ resource "huaweicloud_evs_volume" "test" {
 `, rName, acceptance.HW_ENTERPRISE_PROJECT_ID_TEST)
 }
 
+func testAccEvsVolume_provisionedPerformance(rName, volumeType string, iops, throughput int) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_evs_volume" "test" {
+  name              = "%s"
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  volume_type       = "%s"
+  size              = 100
+  iops              = %d
+  throughput        = %d
+}
+`, rName, volumeType, iops, throughput)
+}
+
+func testAccEvsVolume_retype(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_evs_volume" "test" {
+  name              = "%s"
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  volume_type       = "ESSD2"
+  size              = 100
+  iops              = 10000
+}
+`, rName)
+}
+
 func testAccEvsVolume_prePaid(rName string, isAutoRenew bool) string {
 	return fmt.Sprintf(`
 data "huaweicloud_availability_zones" "test" {}
@@ -0,0 +1,45 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceGaussDBMysqlNodes_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_gaussdb_mysql_nodes.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceGaussDBMysqlNodes_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "nodes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "nodes.0.type", "master"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nodes.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nodes.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "nodes.0.availability_zone"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceGaussDBMysqlNodes_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_gaussdb_mysql_nodes" "test" {
+  instance_id = huaweicloud_gaussdb_mysql_instance.test.id
+  type        = "master"
+}
+`, testAccGaussDBInstanceConfig_basic(rName))
+}
@@ -44,6 +44,9 @@ func TestAccGaussDBInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "audit_log_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo_update", "bar"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key", "value_update"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.status", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.read_only_switch", "ON"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling.0.max_read_only_count", "2"),
 				),
 			},
 		},
@@ -175,6 +178,17 @@ resource "huaweicloud_gaussdb_mysql_instance" "test" {
   enterprise_project_id = "0"
   audit_log_enabled     = true
 
+  auto_scaling {
+    status              = "ON"
+    flavor_switch       = "OFF"
+    read_only_switch    = "ON"
+    monitor_cycle       = 300
+    silence_cycle       = 300
+    enlarge_threshold   = 50
+    max_read_only_count = 2
+    read_only_weight    = 20
+  }
+
   tags = {
     foo_update = "bar"
     key        = "value_update"
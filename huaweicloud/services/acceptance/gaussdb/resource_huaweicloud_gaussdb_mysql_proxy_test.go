@@ -44,17 +44,23 @@ func TestAccGaussDBProxy_basic(t *testing.T) {
 					rc.CheckResourceExists(),
 					resource.TestCheckResourceAttr(resourceName, "flavor", "gaussdb.proxy.xlarge.arm.2"),
 					resource.TestCheckResourceAttr(resourceName, "node_num", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "proxy_id"),
+				),
+			},
+			{
+				Config: testAccMysqlProxy_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "route_mode", "0"),
+					resource.TestCheckResourceAttr(resourceName, "master_node_weight", "100"),
+					resource.TestCheckResourceAttr(resourceName, "consistence_mode", "session"),
+					resource.TestCheckResourceAttr(resourceName, "transaction_split", "ON"),
 				),
 			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"instance_id",
-					"flavor",
-					"node_num",
-				},
 			},
 		},
 	})
@@ -85,3 +91,42 @@ resource "huaweicloud_gaussdb_mysql_proxy" "test" {
 }
 `, common.TestBaseNetwork(rName), rName)
 }
+
+func testAccMysqlProxy_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_gaussdb_mysql_instance" "test" {
+  name        = "%s"
+  password    = "Test@12345678"
+  flavor      = "gaussdb.mysql.2xlarge.x86.4"
+  vpc_id      = huaweicloud_vpc.test.id
+  subnet_id   = huaweicloud_vpc_subnet.test.id
+
+  security_group_id = huaweicloud_networking_secgroup.test.id
+
+  enterprise_project_id = "0"
+}
+
+resource "huaweicloud_gaussdb_mysql_proxy" "test" {
+  instance_id        = huaweicloud_gaussdb_mysql_instance.test.id
+  flavor             = "gaussdb.proxy.xlarge.arm.2"
+  node_num           = 3
+  route_mode         = 0
+  master_node_weight = 100
+  consistence_mode   = "session"
+  transaction_split  = "ON"
+
+  dynamic "readonly_nodes_weight" {
+    for_each = [for node in huaweicloud_gaussdb_mysql_instance.test.nodes : node.id if node.type == "slave"]
+
+    content {
+      id     = readonly_nodes_weight.value
+      weight = 200
+    }
+  }
+}
+`, common.TestBaseNetwork(rName), rName)
+}
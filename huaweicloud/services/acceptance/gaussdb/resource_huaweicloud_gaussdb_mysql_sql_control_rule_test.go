@@ -0,0 +1,113 @@
+package gaussdb
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getGaussDBSqlControlRuleFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.GaussdbV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GaussDB client: %s", err)
+	}
+
+	getSqlControlRulePath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/sql-filter/rules" +
+		"?node_id={node_id}&sql_type={sql_type}"
+	getSqlControlRulePath = strings.ReplaceAll(getSqlControlRulePath, "{project_id}", client.ProjectID)
+	getSqlControlRulePath = strings.ReplaceAll(getSqlControlRulePath, "{instance_id}",
+		state.Primary.Attributes["instance_id"])
+	getSqlControlRulePath = strings.ReplaceAll(getSqlControlRulePath, "{node_id}", state.Primary.Attributes["node_id"])
+	getSqlControlRulePath = strings.ReplaceAll(getSqlControlRulePath, "{sql_type}",
+		state.Primary.Attributes["sql_type"])
+
+	getSqlControlRuleOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getSqlControlRuleResp, err := client.Request("GET", getSqlControlRulePath, &getSqlControlRuleOpt)
+	if err != nil {
+		return nil, err
+	}
+	getSqlControlRuleRespBody, err := utils.FlattenResponse(getSqlControlRuleResp)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("sql_filter_rules[?sql_type=='%s']|[0].patterns[?pattern=='%s']|[0]",
+		state.Primary.Attributes["sql_type"], state.Primary.Attributes["pattern"])
+	rule := utils.PathSearch(expression, getSqlControlRuleRespBody, nil)
+	if rule == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rule, nil
+}
+
+func TestAccGaussDBSqlControlRule_basic(t *testing.T) {
+	var obj interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_gaussdb_mysql_sql_control_rule.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getGaussDBSqlControlRuleFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGaussDBSqlControlRule_basic(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "node_id",
+						"huaweicloud_gaussdb_mysql_instance.test", "nodes.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "sql_type", "SELECT"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "select~from~t1"),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "20"),
+				),
+			},
+			{
+				Config: testAccGaussDBSqlControlRule_basic(rName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "50"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGaussDBSqlControlRule_basic(rName string, maxConcurrency int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_gaussdb_mysql_sql_control_rule" "test" {
+  instance_id     = huaweicloud_gaussdb_mysql_instance.test.id
+  node_id         = huaweicloud_gaussdb_mysql_instance.test.nodes[0].id
+  sql_type        = "SELECT"
+  pattern         = "select~from~t1"
+  max_concurrency = %d
+}
+`, testAccGaussDBInstanceConfig_basic(rName), maxConcurrency)
+}
@@ -0,0 +1,46 @@
+package ims
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccImsImageExport_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_images_image_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBSBucket(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImsImageExport_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_id", "huaweicloud_images_image.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "file_format", "qcow2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImsImageExport_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_images_image_export" "test" {
+  image_id    = huaweicloud_images_image.test.id
+  bucket_url  = "%[2]s:%[3]s.qcow2"
+  file_format = "qcow2"
+}
+`, testAccImsImage_basic(rName), acceptance.HW_OBS_BUCKET_NAME, rName)
+}
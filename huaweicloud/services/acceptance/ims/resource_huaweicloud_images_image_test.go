@@ -81,6 +81,56 @@ func TestAccImsImage_withEpsId(t *testing.T) {
 	})
 }
 
+func TestAccImsImage_wholeImage(t *testing.T) {
+	var image cloudimages.Image
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_images_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckImsImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImsImage_wholeImage(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImsImageExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"huaweicloud_compute_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImsImage_dataImage(t *testing.T) {
+	var image cloudimages.Image
+
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_images_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckImsImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImsImage_dataImage(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImsImageExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImsImageDestroy(s *terraform.State) error {
 	cfg := acceptance.TestAccProvider.Meta().(*config.Config)
 	imageClient, err := cfg.ImageV2Client(acceptance.HW_REGION_NAME)
@@ -244,3 +294,76 @@ resource "huaweicloud_images_image" "test" {
 }
 `, common.TestBaseNetwork(rName), rName, acceptance.HW_ENTERPRISE_PROJECT_ID_TEST)
 }
+
+func testAccImsImage_instanceBase(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_compute_flavors" "test" {
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  performance_type  = "normal"
+  cpu_core_count    = 2
+  memory_size       = 4
+}
+
+resource "huaweicloud_compute_instance" "test" {
+  name               = "%[2]s"
+  image_name         = "Ubuntu 18.04 server 64bit"
+  flavor_id          = data.huaweicloud_compute_flavors.test.ids[0]
+  security_group_ids = [huaweicloud_networking_secgroup.test.id]
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+
+  network {
+    uuid = huaweicloud_vpc_subnet.test.id
+  }
+
+  data_disks {
+    type = "SAS"
+    size = 10
+  }
+}
+`, common.TestBaseNetwork(rName), rName)
+}
+
+func testAccImsImage_wholeImage(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_cbr_vault" "test" {
+  name             = "%[2]s"
+  type             = "server"
+  consistent_level = "crash_consistent"
+  protection_type  = "backup"
+  size             = 200
+}
+
+resource "huaweicloud_images_image" "test" {
+  name        = "%[2]s"
+  instance_id = huaweicloud_compute_instance.test.id
+  vault_id    = huaweicloud_cbr_vault.test.id
+  description = "created by Terraform AccTest"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccImsImage_instanceBase(rName), rName)
+}
+
+func testAccImsImage_dataImage(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_images_image" "test" {
+  name        = "%[2]s"
+  volume_id   = huaweicloud_compute_instance.test.volume_attached[1].volume_id
+  description = "created by Terraform AccTest"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccImsImage_instanceBase(rName), rName)
+}
@@ -0,0 +1,45 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRdsParametersDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_rds_parameters.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsParametersDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters.0.name", "connect_timeout"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parameters.0.value"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parameters.0.value_range"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parameters.0.restart_required"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsParametersDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_rds_parameters" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = "connect_timeout"
+}
+`, testRdsAccount_base(rName))
+}
@@ -0,0 +1,43 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRdsPgAccountsDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_rds_pg_accounts.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsPgAccountsDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "users.0.attributes.0.rolcanlogin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsPgAccountsDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_rds_pg_accounts" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  user_name   = huaweicloud_rds_pg_account.test.name
+}
+`, testRdsPgAccount_basic(rName, "Test@12345678"))
+}
@@ -0,0 +1,45 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRdsPgDatabasesDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_rds_pg_databases.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsPgDatabasesDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "databases.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "databases.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "databases.0.owner", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "databases.0.character_set", "UTF8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsPgDatabasesDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_rds_pg_databases" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = huaweicloud_rds_pg_database.test.name
+  owner       = huaweicloud_rds_pg_database.test.owner
+}
+`, testRdsPgDatabase_basic(rName))
+}
@@ -0,0 +1,43 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRdsRestoreTimeRangesDataSource_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_rds_restore_time_ranges.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsRestoreTimeRangesDataSource_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dataSourceName, "restore_time.0.start_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "restore_time.0.end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsRestoreTimeRangesDataSource_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_rds_restore_time_ranges" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+
+  depends_on = [huaweicloud_rds_backup.test]
+}
+`, testBackup_basic(name))
+}
@@ -0,0 +1,43 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRdsSqlserverDatabasesDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_rds_sqlserver_databases.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsSqlserverDatabasesDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "databases.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "databases.0.name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "databases.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsSqlserverDatabasesDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_rds_sqlserver_databases" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = huaweicloud_rds_sqlserver_database.test.name
+}
+`, testRdsSqlserverDatabase_basic(rName))
+}
@@ -0,0 +1,108 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRdsCrossRegionBackupStrategyFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	response, err := client.ShowOffSiteBackupPolicy(&model.ShowOffSiteBackupPolicyRequest{
+		InstanceId: state.Primary.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if response.PolicyPara != nil {
+		for _, policy := range *response.PolicyPara {
+			if policy.KeepDays != nil && *policy.KeepDays > 0 {
+				return policy, nil
+			}
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func TestAccRdsCrossRegionBackupStrategy_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_rds_cross_region_backup_strategy.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getRdsCrossRegionBackupStrategyFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckReplication(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsCrossRegionBackupStrategy_basic(rName, "auto", 5),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "backup_type", "auto"),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "5"),
+					resource.TestCheckResourceAttr(resourceName, "destination_region", acceptance.HW_DEST_REGION),
+					resource.TestCheckResourceAttr(resourceName, "destination_project_id",
+						acceptance.HW_DEST_PROJECT_ID),
+				),
+			},
+			{
+				Config: testRdsCrossRegionBackupStrategy_basic(rName, "all", 8),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "backup_type", "all"),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "8"),
+				),
+			},
+			{
+				Config: testRdsCrossRegionBackupStrategy_basic(rName, "auto", 8),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "backup_type", "auto"),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testRdsCrossRegionBackupStrategy_basic(rName, backupType string, keepDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_cross_region_backup_strategy" "test" {
+  instance_id            = huaweicloud_rds_instance.test.id
+  backup_type            = "%s"
+  keep_days              = %d
+  destination_region     = "%s"
+  destination_project_id = "%s"
+}
+`, testRdsAccount_base(rName), backupType, keepDays, acceptance.HW_DEST_REGION, acceptance.HW_DEST_PROJECT_ID)
+}
@@ -167,6 +167,10 @@ func TestAccRdsInstance_mysql(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "fixed_ip", "192.168.0.58"),
 					resource.TestCheckResourceAttr(resourceName, "db.0.port", "3308"),
 					resource.TestCheckResourceAttr(resourceName, "db.0.password", newPwd),
+					resource.TestCheckResourceAttr(resourceName, "db.0.version", "8.0"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.limit_size", "400"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.trigger_threshold", "15"),
+					resource.TestCheckResourceAttr(resourceName, "binlog_retention_hours", "12"),
 				),
 			},
 		},
@@ -264,6 +268,32 @@ func TestAccRdsInstance_withParameters(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "parameters.0.name", "connect_timeout"),
 					resource.TestCheckResourceAttr(resourceName, "parameters.0.value", "14"),
+					resource.TestCheckResourceAttr(resourceName, "restart_on_parameter_change", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRdsInstance_restore(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := acceptance.RandomAccResourceName()
+	resourceType := "huaweicloud_rds_instance"
+	resourceName := "huaweicloud_rds_instance.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstance_restore(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"_restore"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.backup_id",
+						"huaweicloud_rds_backup.test", "id"),
 				),
 			},
 		},
@@ -531,6 +561,8 @@ resource "huaweicloud_rds_instance" "test" {
   fixed_ip            = "192.168.0.58"
   ha_replication_mode = "semisync"
 
+  binlog_retention_hours = 12
+
   availability_zone = [
     data.huaweicloud_availability_zones.test.names[0],
     data.huaweicloud_availability_zones.test.names[3],
@@ -539,13 +571,15 @@ resource "huaweicloud_rds_instance" "test" {
   db {
     password = "%s"
     type     = "MySQL"
-    version  = "5.7"
+    version  = "8.0"
     port     = 3308
   }
 
   volume {
-    type = "LOCALSSD"
-    size = 40
+    type              = "LOCALSSD"
+    size              = 40
+    limit_size        = 400
+    trigger_threshold = 15
   }
 }
 `, common.TestBaseNetwork(name), name, pwd)
@@ -706,6 +740,38 @@ resource "huaweicloud_rds_instance" "test" {
     name  = "connect_timeout"
     value = "14"
   }
+
+  restart_on_parameter_change = true
 }
 `, common.TestBaseNetwork(name), name)
 }
+
+func testAccRdsInstance_restore(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_rds_instance" "restore" {
+  name              = "%[2]s_restore"
+  flavor            = "rds.pg.n1.large.2"
+  availability_zone = [data.huaweicloud_availability_zones.test.names[0]]
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "12"
+  }
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+
+  restore {
+    instance_id = huaweicloud_rds_instance.test.id
+    backup_id   = huaweicloud_rds_backup.test.id
+  }
+}
+`, testBackup_basic(name), name)
+}
@@ -0,0 +1,153 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+)
+
+func getRdsPgAccountFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and user from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<user>")
+	}
+	users, err := rds.QueryPgAccounts(client, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.Name == parts[1] {
+			return user, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func TestAccRdsPgAccount_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_rds_pg_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getRdsPgAccountFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsPgAccount_basic(rName, "Test@12345678"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.rolcanlogin", "true"),
+				),
+			},
+			{
+				Config: testRdsPgAccount_basic(rName, "Test@123456789"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "password", "Test@123456789"),
+				),
+			},
+			{
+				Config: testRdsPgAccount_attributes(rName, "Test@123456789"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.rolcreatedb", "true"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.rolcreaterole", "true"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.rolreplication", "false"),
+					resource.TestCheckResourceAttr(resourceName, "memberof.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "memberof.*", "pg_monitor"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testRdsPgInstance_base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_rds_instance" "test" {
+  name              = "%s"
+  flavor            = "rds.pg.n1.large.2"
+  availability_zone = [data.huaweicloud_availability_zones.test.names[0]]
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "12"
+    port     = 8635
+  }
+
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+}
+`, common.TestBaseNetwork(rName), rName)
+}
+
+func testRdsPgAccount_basic(rName, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_account" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = "%s"
+  password    = "%s"
+}
+`, testRdsPgInstance_base(rName), rName, password)
+}
+
+func testRdsPgAccount_attributes(rName, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_account" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = "%s"
+  password    = "%s"
+  memberof    = ["pg_monitor"]
+
+  attributes {
+    rolcreatedb    = true
+    rolcreaterole  = true
+    rolreplication = false
+  }
+}
+`, testRdsPgInstance_base(rName), rName, password)
+}
@@ -0,0 +1,62 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRdsPgDatabasePrivilege_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_rds_pg_database_privilege.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsPgDatabasePrivilege_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "db_name",
+						"huaweicloud_rds_pg_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "users.0.readonly", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"users",
+				},
+			},
+		},
+	})
+}
+
+func testRdsPgDatabasePrivilege_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_account" "reader" {
+  instance_id = huaweicloud_rds_instance.test.id
+  name        = "%s_reader"
+  password    = "Test@12345678"
+}
+
+resource "huaweicloud_rds_pg_database_privilege" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  db_name     = huaweicloud_rds_pg_database.test.name
+
+  users {
+    name        = huaweicloud_rds_pg_account.reader.name
+    schema_name = huaweicloud_rds_pg_schema.test.schema_name
+    readonly    = true
+  }
+}
+`, testRdsPgSchema_basic(rName), rName)
+}
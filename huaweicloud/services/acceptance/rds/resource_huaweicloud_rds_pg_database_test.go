@@ -0,0 +1,96 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getRdsPgDatabaseFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	databases, err := rds.QueryPgDatabases(client, parts[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, db := range databases {
+		if utils.StringValue(db.Name) == parts[1] {
+			return db, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func TestAccRdsPgDatabase_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_rds_pg_database.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getRdsPgDatabaseFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsPgDatabase_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "owner",
+						"huaweicloud_rds_pg_account.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "character_set", "UTF8"),
+					resource.TestCheckResourceAttr(resourceName, "lc_collate", "en_US.UTF-8"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"template", "lc_ctype", "is_revoke_public_privilege",
+				},
+			},
+		},
+	})
+}
+
+func testRdsPgDatabase_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_database" "test" {
+  instance_id                = huaweicloud_rds_instance.test.id
+  name                       = "%s"
+  owner                      = huaweicloud_rds_pg_account.test.name
+  character_set              = "UTF8"
+  template                   = "template0"
+  lc_collate                 = "en_US.UTF-8"
+  lc_ctype                   = "en_US.UTF-8"
+  is_revoke_public_privilege = true
+}
+`, testRdsPgAccount_basic(rName, "Test@12345678"), rName)
+}
@@ -0,0 +1,50 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccRdsPgSchema_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_rds_pg_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsPgSchema_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "schema_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "db_name",
+						"huaweicloud_rds_pg_database.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner",
+						"huaweicloud_rds_pg_account.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testRdsPgSchema_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_pg_schema" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+  db_name     = huaweicloud_rds_pg_database.test.name
+  schema_name = "%s"
+  owner       = huaweicloud_rds_pg_account.test.name
+}
+`, testRdsPgDatabase_basic(rName), rName)
+}
@@ -0,0 +1,85 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRdsSqlAuditFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.HcRdsV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	response, err := client.ShowAuditlogPolicy(&model.ShowAuditlogPolicyRequest{InstanceId: state.Primary.ID})
+	if err != nil {
+		return nil, err
+	}
+	if response.KeepDays == nil || *response.KeepDays == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return response, nil
+}
+
+func TestAccRdsSqlAudit_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_rds_sql_audit.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getRdsSqlAuditFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testRdsSqlAudit_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id",
+						"huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "5"),
+				),
+			},
+			{
+				Config: testRdsSqlAudit_basic(rName, 9),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "keep_days", "9"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reserve_auditlogs"},
+			},
+		},
+	})
+}
+
+func testRdsSqlAudit_basic(rName string, keepDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_sql_audit" "test" {
+  instance_id       = huaweicloud_rds_instance.test.id
+  keep_days         = %d
+  reserve_auditlogs = false
+}
+`, testRdsAccount_base(rName), keepDays)
+}
@@ -0,0 +1,47 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccVpcNetworkAclsDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_vpc_network_acls.test"
+
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcNetworkAclsDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acls.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_acls.0.id",
+						"huaweicloud_vpc_network_acl.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acls.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acls.0.ingress_rules.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acls.0.ingress_rules.0.destination_port", "22"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_acls.0.associated_subnets.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcNetworkAclsDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpc_network_acls" "test" {
+  network_acl_id = huaweicloud_vpc_network_acl.test.id
+}
+`, testAccVpcNetworkAcl_basic(rName))
+}
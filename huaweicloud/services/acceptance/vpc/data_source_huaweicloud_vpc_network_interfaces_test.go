@@ -0,0 +1,47 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccVpcNetworkInterfacesDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_vpc_network_interfaces.test"
+
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcNetworkInterfacesDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_interfaces.0.id",
+						"huaweicloud_vpc_network_interface.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_interfaces.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "network_interfaces.0.fixed_ip", "192.168.0.100"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_interfaces.0.secondary_private_ips.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_interfaces.0.source_dest_check", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcNetworkInterfacesDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpc_network_interfaces" "test" {
+  network_interface_id = huaweicloud_vpc_network_interface.test.id
+}
+`, testAccVpcNetworkInterface_basic(rName))
+}
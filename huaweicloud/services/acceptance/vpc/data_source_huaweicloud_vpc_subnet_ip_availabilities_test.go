@@ -0,0 +1,55 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccVpcSubnetIpAvailabilitiesDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_vpc_subnet_ip_availabilities.test"
+	byVpc := "data.huaweicloud_vpc_subnet_ip_availabilities.by_vpc"
+
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcSubnetIpAvailabilitiesDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "ip_availabilities.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ip_availabilities.0.subnet_id",
+						"huaweicloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "ip_availabilities.0.cidr", "192.168.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "ip_availabilities.0.ip_version", "4"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ip_availabilities.0.total_ips"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ip_availabilities.0.available_ips"),
+					resource.TestCheckResourceAttr(byVpc, "ip_availabilities.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcSubnetIpAvailabilitiesDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpc_subnet_ip_availabilities" "test" {
+  subnet_ids = [huaweicloud_vpc_subnet.test.id]
+}
+
+data "huaweicloud_vpc_subnet_ip_availabilities" "by_vpc" {
+  vpc_id = huaweicloud_vpc.test.id
+
+  depends_on = [huaweicloud_vpc_subnet.test]
+}
+`, testAccVpcNetworkInterface_base(rName))
+}
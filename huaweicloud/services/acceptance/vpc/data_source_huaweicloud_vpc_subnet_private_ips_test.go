@@ -0,0 +1,46 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccVpcSubnetPrivateIpsDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	dataSourceName := "data.huaweicloud_vpc_subnet_private_ips.test"
+
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcSubnetPrivateIpsDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "private_ips.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "private_ips.0.ip_address", "192.168.0.100"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "private_ips.0.port_id",
+						"huaweicloud_vpc_network_interface.test", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_ips.0.mac_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVpcSubnetPrivateIpsDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_vpc_subnet_private_ips" "test" {
+  subnet_id  = huaweicloud_vpc_subnet.test.id
+  ip_address = huaweicloud_vpc_network_interface.test.fixed_ip
+}
+`, testAccVpcNetworkInterface_basic(rName))
+}
@@ -0,0 +1,138 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	v3Rules "github.com/chnsz/golangsdk/openstack/networking/v3/security/rules"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func testAccCheckSecGroupRulesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource (%s) not found", n)
+		}
+
+		cfg := acceptance.TestAccProvider.Meta().(*config.Config)
+		client, err := cfg.NetworkingV3Client(acceptance.HW_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("error creating VPC v3 client: %s", err)
+		}
+
+		rules, err := v3Rules.List(client, v3Rules.ListOpts{SecurityGroupId: rs.Primary.ID})
+		if err != nil {
+			return err
+		}
+		if len(rules) != count {
+			return fmt.Errorf("expected %d rules in security group (%s), but got %d", count, rs.Primary.ID,
+				len(rules))
+		}
+		return nil
+	}
+}
+
+func TestAccNetworkingSecGroupRules_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_networking_secgroup_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkingSecGroupRules_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id",
+						"huaweicloud_networking_secgroup.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "3"),
+					testAccCheckSecGroupRulesCount(resourceName, 3),
+				),
+			},
+			{
+				Config: testAccNetworkingSecGroupRules_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rules.*", map[string]string{
+						"remote_ip_prefix": "192.168.0.10/32",
+					}),
+					testAccCheckSecGroupRulesCount(resourceName, 2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetworkingSecGroupRules_base(rName string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_networking_secgroup" "test" {
+  name                 = "%s"
+  delete_default_rules = true
+}
+`, rName)
+}
+
+func testAccNetworkingSecGroupRules_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_networking_secgroup_rules" "test" {
+  security_group_id = huaweicloud_networking_secgroup.test.id
+
+  rules {
+    direction        = "ingress"
+    protocol         = "tcp"
+    ports            = "22"
+    remote_ip_prefix = "10.0.0.0/8"
+    description      = "ssh"
+  }
+
+  rules {
+    direction        = "ingress"
+    protocol         = "tcp"
+    ports            = "80,443"
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+
+  rules {
+    direction        = "egress"
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`, testAccNetworkingSecGroupRules_base(rName))
+}
+
+func testAccNetworkingSecGroupRules_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_networking_secgroup_rules" "test" {
+  security_group_id = huaweicloud_networking_secgroup.test.id
+
+  rules {
+    direction        = "ingress"
+    protocol         = "tcp"
+    ports            = "22"
+    remote_ip_prefix = "192.168.0.10"
+    description      = "ssh"
+    priority         = 10
+  }
+
+  rules {
+    direction        = "egress"
+    remote_ip_prefix = "0.0.0.0/0"
+  }
+}
+`, testAccNetworkingSecGroupRules_base(rName))
+}
@@ -0,0 +1,162 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpc"
+)
+
+func getNetworkAclResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.NetworkingV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC v3 client: %s", err)
+	}
+	return vpc.GetNetworkAcl(client, state.Primary.ID)
+}
+
+func TestAccVpcNetworkAcl_basic(t *testing.T) {
+	var acl interface{}
+
+	rName := acceptance.RandomAccResourceName()
+	rNameUpdate := rName + "_update"
+	resourceName := "huaweicloud_vpc_network_acl.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&acl,
+		getNetworkAclResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcNetworkAcl_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rules.0.destination_port", "22"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rules.1.action", "deny"),
+					resource.TestCheckResourceAttrPair(resourceName, "ingress_rules.1.source_address_group_id",
+						"huaweicloud_vpc_address_group.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rules.0.ip_version", "6"),
+					resource.TestCheckResourceAttr(resourceName, "associated_subnets.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingress_rules.0.rule_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testAccVpcNetworkAcl_update(rNameUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdate),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rules.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rules.0.destination_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "ingress_rules.2.destination_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "egress_rules.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "associated_subnets.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVpcNetworkAcl_base(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpc_subnet" "test" {
+  name       = "%[2]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = huaweicloud_vpc.test.id
+}
+
+resource "huaweicloud_vpc_address_group" "test" {
+  name      = "%[2]s"
+  addresses = ["192.168.10.0/24"]
+}
+`, testAccVpcSubnet_base(rName), rName)
+}
+
+func testAccVpcNetworkAcl_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpc_network_acl" "test" {
+  name               = "%[2]s"
+  description        = "created by acc test"
+  associated_subnets = [huaweicloud_vpc_subnet.test.id]
+
+  ingress_rules {
+    name              = "ssh"
+    action            = "allow"
+    protocol          = "tcp"
+    source_ip_address = "10.0.0.0/8"
+    destination_port  = "22"
+  }
+
+  ingress_rules {
+    action                  = "deny"
+    protocol                = "any"
+    source_address_group_id = huaweicloud_vpc_address_group.test.id
+  }
+
+  egress_rules {
+    action                 = "allow"
+    protocol               = "any"
+    ip_version             = 6
+    destination_ip_address = "::/0"
+  }
+}
+`, testAccVpcNetworkAcl_base(rName), rName)
+}
+
+func testAccVpcNetworkAcl_update(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpc_network_acl" "test" {
+  name        = "%[2]s"
+  description = "updated by acc test"
+  enabled     = false
+
+  ingress_rules {
+    action            = "allow"
+    protocol          = "tcp"
+    source_ip_address = "10.0.0.0/8"
+    destination_port  = "443"
+  }
+
+  ingress_rules {
+    action                  = "deny"
+    protocol                = "any"
+    source_address_group_id = huaweicloud_vpc_address_group.test.id
+  }
+
+  ingress_rules {
+    action           = "allow"
+    protocol         = "tcp"
+    destination_port = "8080"
+    enabled          = false
+  }
+}
+`, testAccVpcNetworkAcl_base(rName), rName)
+}
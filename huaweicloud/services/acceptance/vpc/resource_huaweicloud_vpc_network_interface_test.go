@@ -0,0 +1,136 @@
+package vpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/vpc"
+)
+
+func getVpcNetworkInterfaceResourceFunc(conf *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := conf.NetworkingV2Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+	return vpc.GetNetworkInterface(client, state.Primary.ID)
+}
+
+func TestAccVpcNetworkInterface_basic(t *testing.T) {
+	var port ports.Port
+
+	rName := acceptance.RandomAccResourceName()
+	rNameUpdate := rName + "_update"
+	resourceName := "huaweicloud_vpc_network_interface.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&port,
+		getVpcNetworkInterfaceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcNetworkInterface_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "huaweicloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_ip", "192.168.0.100"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_dest_check", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_ids.0",
+						"huaweicloud_networking_secgroup.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttrSet(resourceName, "mac_address"),
+				),
+			},
+			{
+				Config: testAccVpcNetworkInterface_update(rNameUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdate),
+					resource.TestCheckResourceAttr(resourceName, "fixed_ip", "192.168.0.100"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_private_ips.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source_dest_check", "false"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_address_pairs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_address_pairs.0.ip_address", "192.168.0.200"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "baz"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVpcNetworkInterface_base(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpc_subnet" "test" {
+  name       = "%[2]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = huaweicloud_vpc.test.id
+}
+
+resource "huaweicloud_networking_secgroup" "test" {
+  name = "%[2]s"
+}
+`, testAccVpcSubnet_base(rName), rName)
+}
+
+func testAccVpcNetworkInterface_basic(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpc_network_interface" "test" {
+  name                  = "%[2]s"
+  subnet_id             = huaweicloud_vpc_subnet.test.id
+  fixed_ip              = "192.168.0.100"
+  secondary_private_ips = ["192.168.0.101"]
+  security_group_ids    = [huaweicloud_networking_secgroup.test.id]
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testAccVpcNetworkInterface_base(rName), rName)
+}
+
+func testAccVpcNetworkInterface_update(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_vpc_network_interface" "test" {
+  name                  = "%[2]s"
+  subnet_id             = huaweicloud_vpc_subnet.test.id
+  fixed_ip              = "192.168.0.100"
+  secondary_private_ips = ["192.168.0.101", "192.168.0.102"]
+  security_group_ids    = [huaweicloud_networking_secgroup.test.id]
+  source_dest_check     = false
+
+  allowed_address_pairs {
+    ip_address = "192.168.0.200"
+  }
+
+  tags = {
+    foo = "baz"
+  }
+}
+`, testAccVpcNetworkInterface_base(rName), rName)
+}
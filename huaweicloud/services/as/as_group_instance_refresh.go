@@ -0,0 +1,194 @@
+package as
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/groups"
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/instances"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func instanceRefreshSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"min_healthy_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      90,
+					ValidateFunc: validation.IntBetween(0, 100),
+					Description: "The percentage of the desired instances which must remain in service during the " +
+						"instance refresh.",
+				},
+				"batch_size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The number of instances to be replaced in each batch.",
+				},
+				"checkpoint_delay": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The seconds to pause after each batch is replaced.",
+				},
+			},
+		},
+		Description: "The instance refresh which is triggered when the scaling configuration is changed.",
+	}
+}
+
+// instanceRefreshBatchSize returns the number of the instances to be replaced in the next batch.
+func instanceRefreshBatchSize(refresh map[string]interface{}, desireNum, outdatedNum int) int {
+	batchSize := refresh["batch_size"].(int)
+	if batchSize == 0 {
+		healthyNum := int(math.Ceil(float64(desireNum*refresh["min_healthy_percentage"].(int)) / 100))
+		batchSize = desireNum - healthyNum
+	}
+	// At least one instance is replaced in each batch, otherwise the instance refresh never ends.
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	if batchSize > outdatedNum {
+		batchSize = outdatedNum
+	}
+	return batchSize
+}
+
+func updateASGroupCapacity(client *golangsdk.ServiceClient, groupID string, minNum, desireNum, maxNum int) error {
+	updateOpts := groups.UpdateOpts{
+		DesireInstanceNumber: desireNum,
+		MinInstanceNumber:    minNum,
+		MaxInstanceNumber:    maxNum,
+	}
+	if _, err := groups.Update(client, groupID, updateOpts).Extract(); err != nil {
+		return fmt.Errorf("error updating the instance numbers of AS group %s: %s", groupID, err)
+	}
+	return nil
+}
+
+func refreshInstancesRemoved(client *golangsdk.ServiceClient, groupID string,
+	instanceIds []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		allIns, err := getInstancesInGroup(client, groupID, nil)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		for _, ins := range allIns {
+			if utils.StrSliceContains(instanceIds, ins.ID) {
+				return allIns, "REMOVING", nil
+			}
+		}
+		return allIns, "REMOVED", nil
+	}
+}
+
+// refreshASGroupInstances replaces the instances which are not created by the current scaling configuration in
+// batches. In each batch, the desired (and the max if necessary) instance number is raised by the batch size to create
+// the new instances with the current scaling configuration first, then the outdated instances of the batch are removed
+// and deleted, and the instance numbers are restored. So the instance refresh works even if the desired instance number
+// equals the min instance number, and the instance numbers are restored if any batch fails.
+func refreshASGroupInstances(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData) (err error) {
+	var (
+		groupID    = d.Id()
+		configID   = d.Get("scaling_configuration_id").(string)
+		refresh    = d.Get("instance_refresh.0").(map[string]interface{})
+		checkpoint = time.Duration(refresh["checkpoint_delay"].(int)) * time.Second
+		timeout    = d.Timeout(schema.TimeoutUpdate)
+	)
+
+	asg, err := groups.Get(client, groupID).Extract()
+	if err != nil {
+		return fmt.Errorf("error retrieving AS group %s: %s", groupID, err)
+	}
+	minNum, desireNum, maxNum := asg.MinInstanceNumber, asg.DesireInstanceNumber, asg.MaxInstanceNumber
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		if restoreErr := updateASGroupCapacity(client, groupID, minNum, desireNum, maxNum); restoreErr != nil {
+			log.Printf("[WARN] failed to restore the instance numbers of AS group %s: %s", groupID, restoreErr)
+		}
+	}()
+
+	for batch := 0; ; batch++ {
+		var allIns []instances.Instance
+		allIns, err = getInstancesInGroup(client, groupID, nil)
+		if err != nil {
+			return err
+		}
+		outdatedIds := make([]string, 0)
+		for _, ins := range allIns {
+			if ins.ID != "" && ins.ConfigurationID != configID {
+				outdatedIds = append(outdatedIds, ins.ID)
+			}
+		}
+		if len(outdatedIds) == 0 {
+			return nil
+		}
+		if batch > 0 && checkpoint > 0 {
+			log.Printf("[DEBUG] Pausing %s before the next batch of the instance refresh", checkpoint)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(checkpoint):
+			}
+		}
+
+		batchSize := instanceRefreshBatchSize(refresh, desireNum, len(outdatedIds))
+		batchIds := outdatedIds[:batchSize]
+		surgeNum := desireNum + batchSize
+		log.Printf("[DEBUG] Refreshing the instances of AS group %s: %v, surging to %d instances", groupID, batchIds,
+			surgeNum)
+		surgeMaxNum := maxNum
+		if surgeMaxNum < surgeNum {
+			surgeMaxNum = surgeNum
+		}
+		if err = updateASGroupCapacity(client, groupID, minNum, surgeNum, surgeMaxNum); err != nil {
+			return err
+		}
+		if err = checkASGroupInstancesInService(ctx, client, groupID, surgeNum, timeout); err != nil {
+			return fmt.Errorf("error waiting for the new instances in the AS group %s to become inservice: %s",
+				groupID, err)
+		}
+
+		if err = instances.BatchDelete(client, groupID, batchIds, "yes").ExtractErr(); err != nil {
+			return fmt.Errorf("error removing instances %v from AS group %s: %s", batchIds, groupID, err)
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"REMOVING"},
+			Target:       []string{"REMOVED"},
+			Refresh:      refreshInstancesRemoved(client, groupID, batchIds),
+			Timeout:      timeout,
+			Delay:        10 * time.Second,
+			PollInterval: 10 * time.Second,
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for instances %v to be removed from AS group %s: %s", batchIds,
+				groupID, err)
+		}
+
+		// Removing the instances may or may not decrease the desired instance number, restore the instance numbers
+		// explicitly.
+		if err = updateASGroupCapacity(client, groupID, minNum, desireNum, maxNum); err != nil {
+			return err
+		}
+		if err = checkASGroupInstancesInService(ctx, client, groupID, desireNum, timeout); err != nil {
+			return fmt.Errorf("error waiting for instances in the AS group %s to become inservice: %s", groupID, err)
+		}
+	}
+}
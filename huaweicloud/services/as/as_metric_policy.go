@@ -0,0 +1,181 @@
+package as
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/policies"
+	"github.com/chnsz/golangsdk/openstack/cloudeyeservice/v1/alarmrule"
+)
+
+// The metrics of the AS group are reported by the instances with the following namespace and dimension.
+const (
+	asMetricNamespace = "SYS.AS"
+	asMetricDimension = "AutoScalingGroup"
+)
+
+// metricPolicySchemas returns the schemas of the CES alarm rule which triggers the metric based policies.
+func metricPolicySchemas() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metric_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "cpu_util",
+			Description: "The metric of the AS group which triggers the scaling actions.",
+		},
+		"period": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntInSlice([]int{1, 300, 1200, 3600, 14400, 86400}),
+			Description:  "The period in seconds over which the metric is aggregated.",
+		},
+		"evaluation_count": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      3,
+			ValidateFunc: validation.IntBetween(1, 5),
+			Description:  "The number of consecutive periods in which the threshold is breached.",
+		},
+		"cool_down_time": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntBetween(0, 86400),
+			Description:  "The cooling duration (in seconds) of the scaling actions.",
+		},
+	}
+}
+
+// metricAlarmPolicy is a CES alarm rule with the AS policy which is triggered by the alarm.
+type metricAlarmPolicy struct {
+	Name        string
+	MetricName  string
+	Operator    string
+	Threshold   float64
+	Period      int
+	Count       int
+	Operation   string
+	InstanceNum int
+	CoolDown    int
+}
+
+func buildMetricAlarmCondition(opts metricAlarmPolicy) alarmrule.ConditionOpts {
+	return alarmrule.ConditionOpts{
+		Period:             opts.Period,
+		Filter:             "average",
+		ComparisonOperator: opts.Operator,
+		Value:              opts.Threshold,
+		Count:              opts.Count,
+	}
+}
+
+func createMetricAlarmPolicy(asClient, cesClient *golangsdk.ServiceClient, groupID string,
+	opts metricAlarmPolicy) (policyID, alarmID string, err error) {
+	alarmOpts := alarmrule.CreateOpts{
+		AlarmName: opts.Name,
+		Metric: alarmrule.MetricOpts{
+			Namespace:  asMetricNamespace,
+			MetricName: opts.MetricName,
+			Dimensions: []alarmrule.DimensionOpts{
+				{Name: asMetricDimension, Value: groupID},
+			},
+		},
+		Condition: buildMetricAlarmCondition(opts),
+		AlarmActions: []alarmrule.ActionOpts{
+			{Type: "autoscaling", NotificationList: []string{}},
+		},
+		AlarmEnabled:       true,
+		AlarmActionEnabled: true,
+	}
+	log.Printf("[DEBUG] Create CES alarm rule options: %#v", alarmOpts)
+	alarm, err := alarmrule.Create(cesClient, alarmOpts).Extract()
+	if err != nil {
+		return "", "", fmt.Errorf("error creating CES alarm rule %s: %s", opts.Name, err)
+	}
+
+	policyOpts := policies.CreateOpts{
+		Name:         opts.Name,
+		ID:           groupID,
+		Type:         "ALARM",
+		AlarmID:      alarm.AlarmID,
+		CoolDownTime: opts.CoolDown,
+		Action: policies.ActionOpts{
+			Operation:   opts.Operation,
+			InstanceNum: opts.InstanceNum,
+		},
+	}
+	log.Printf("[DEBUG] Create AS policy options: %#v", policyOpts)
+	policyID, err = policies.Create(asClient, policyOpts).Extract()
+	if err != nil {
+		// the alarm rule is useless without the AS policy
+		if delErr := alarmrule.Delete(cesClient, alarm.AlarmID).ExtractErr(); delErr != nil {
+			log.Printf("[WARN] failed to delete CES alarm rule %s: %s", alarm.AlarmID, delErr)
+		}
+		return "", "", fmt.Errorf("error creating AS policy %s: %s", opts.Name, err)
+	}
+	return policyID, alarm.AlarmID, nil
+}
+
+func updateMetricAlarmPolicy(asClient, cesClient *golangsdk.ServiceClient, policyID, alarmID string,
+	opts metricAlarmPolicy) error {
+	condition := buildMetricAlarmCondition(opts)
+	alarmOpts := alarmrule.UpdateOpts{
+		Name:      opts.Name,
+		Condition: &condition,
+	}
+	if err := alarmrule.Update(cesClient, alarmID, alarmOpts).ExtractErr(); err != nil {
+		return fmt.Errorf("error updating CES alarm rule %s: %s", alarmID, err)
+	}
+
+	policyOpts := policies.UpdateOpts{
+		Name:         opts.Name,
+		Type:         "ALARM",
+		AlarmID:      alarmID,
+		CoolDownTime: opts.CoolDown,
+		Action: policies.ActionOpts{
+			Operation:   opts.Operation,
+			InstanceNum: opts.InstanceNum,
+		},
+	}
+	if _, err := policies.Update(asClient, policyID, policyOpts).Extract(); err != nil {
+		return fmt.Errorf("error updating AS policy %s: %s", policyID, err)
+	}
+	return nil
+}
+
+// deleteMetricAlarmPolicy deletes the AS policy and then the CES alarm rule, the resources which are already deleted
+// are skipped.
+func deleteMetricAlarmPolicy(asClient, cesClient *golangsdk.ServiceClient, policyID, alarmID string) error {
+	if policyID != "" {
+		err := policies.Delete(asClient, policyID).ExtractErr()
+		if _, ok := err.(golangsdk.ErrDefault404); err != nil && !ok {
+			return fmt.Errorf("error deleting AS policy %s: %s", policyID, err)
+		}
+	}
+	if alarmID != "" {
+		err := alarmrule.Delete(cesClient, alarmID).ExtractErr()
+		if _, ok := err.(golangsdk.ErrDefault404); err != nil && !ok {
+			return fmt.Errorf("error deleting CES alarm rule %s: %s", alarmID, err)
+		}
+	}
+	return nil
+}
+
+func getMetricAlarmPolicy(asClient, cesClient *golangsdk.ServiceClient, policyID,
+	alarmID string) (*policies.Policy, *alarmrule.AlarmRule, error) {
+	policy, err := policies.Get(asClient, policyID).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+	alarm, err := alarmrule.Get(cesClient, alarmID).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &policy, alarm, nil
+}
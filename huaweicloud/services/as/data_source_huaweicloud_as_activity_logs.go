@@ -0,0 +1,193 @@
+package as
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceASActivityLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceASActivityLogsRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the AS group is located.",
+			},
+			"scaling_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the AS group to which the activity logs belong.",
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The start time used to query the activity logs, in the format of " +
+					"**YYYY-MM-DDThh:mm:ssZ**.",
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "The end time used to query the activity logs, in the format of " +
+					"**YYYY-MM-DDThh:mm:ssZ**.",
+			},
+			"activity_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the activity log.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the scaling action.",
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start time of the scaling action.",
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end time of the scaling action.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the scaling action.",
+						},
+						"instance_value": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of instances before the scaling action.",
+						},
+						"desire_value": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The desired number of instances of the scaling action.",
+						},
+						"scaling_value": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of instances added or removed by the scaling action.",
+						},
+						"instances_added": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The names of the instances added by the scaling action.",
+						},
+						"instances_deleted": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The names of the instances deleted by the scaling action.",
+						},
+						"instances_removed": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The names of the instances removed by the scaling action.",
+						},
+					},
+				},
+				Description: "A list of the activity logs of the AS group.",
+			},
+		},
+	}
+}
+
+func buildASActivityLogsQueryParams(d *schema.ResourceData, startNumber int) string {
+	res := fmt.Sprintf("?limit=100&start_number=%d", startNumber)
+	if v, ok := d.GetOk("start_time"); ok {
+		res = fmt.Sprintf("%s&start_time=%v", res, v)
+	}
+	if v, ok := d.GetOk("end_time"); ok {
+		res = fmt.Sprintf("%s&end_time=%v", res, v)
+	}
+	return res
+}
+
+func dataSourceASActivityLogsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	listPath := asClient.Endpoint + "autoscaling-api/v1/{project_id}/scaling_activity_log/{scaling_group_id}"
+	listPath = strings.ReplaceAll(listPath, "{project_id}", asClient.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{scaling_group_id}", groupID)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+
+	allLogs := make([]interface{}, 0)
+	for {
+		listResp, err := asClient.Request("GET", listPath+buildASActivityLogsQueryParams(d, len(allLogs)), &listOpt)
+		if err != nil {
+			return diag.Errorf("error retrieving activity logs of AS group %s: %s", groupID, err)
+		}
+		listRespBody, err := utils.FlattenResponse(listResp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		logs := utils.PathSearch("scaling_activity_log", listRespBody, make([]interface{}, 0)).([]interface{})
+		if len(logs) == 0 {
+			break
+		}
+		allLogs = append(allLogs, logs...)
+		total := utils.PathSearch("total_number", listRespBody, float64(0)).(float64)
+		if len(allLogs) >= int(total) {
+			break
+		}
+	}
+
+	d.SetId(groupID)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("activity_logs", flattenASActivityLogs(allLogs)),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error setting AS activity logs fields: %s", mErr)
+	}
+	return nil
+}
+
+func flattenASActivityLogs(logs []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(logs))
+	for i, v := range logs {
+		result[i] = map[string]interface{}{
+			"id":                utils.PathSearch("id", v, nil),
+			"status":            utils.PathSearch("status", v, nil),
+			"start_time":        utils.PathSearch("start_time", v, nil),
+			"end_time":          utils.PathSearch("end_time", v, nil),
+			"description":       utils.PathSearch("description", v, nil),
+			"instance_value":    utils.PathSearch("instance_value", v, nil),
+			"desire_value":      utils.PathSearch("desire_value", v, nil),
+			"scaling_value":     utils.PathSearch("scaling_value", v, nil),
+			"instances_added":   utils.PathSearch("instance_added_list", v, nil),
+			"instances_deleted": utils.PathSearch("instance_deleted_list", v, nil),
+			"instances_removed": utils.PathSearch("instance_removed_list", v, nil),
+		}
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+package as
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/autoscaling/v1/instances"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceASInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceASInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The region where the AS group is located.",
+			},
+			"scaling_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the AS group to which the instances belong.",
+			},
+			"life_cycle_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"INSERVICE", "PENDING", "REMOVING", "PENDING_WAIT", "REMOVING_WAIT", "STANDBY", "ENTERING_STANDBY",
+				}, false),
+				Description: "The life cycle status used to query the instances.",
+			},
+			"health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"INITIALIZING", "NORMAL", "ERROR"}, false),
+				Description:  "The health status used to query the instances.",
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance.",
+						},
+						"instance_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the instance.",
+						},
+						"scaling_configuration_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the AS configuration which creates the instance.",
+						},
+						"scaling_configuration_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the AS configuration which creates the instance.",
+						},
+						"life_cycle_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The life cycle status of the instance.",
+						},
+						"health_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The health status of the instance.",
+						},
+						"protect_from_scaling_down": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the instance is protected from scaling down.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time when the instance is added to the AS group.",
+						},
+					},
+				},
+				Description: "A list of the instances in the AS group.",
+			},
+		},
+	}
+}
+
+func dataSourceASInstancesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	opts := instances.ListOpts{
+		LifeCycleStatus: d.Get("life_cycle_state").(string),
+		HealthStatus:    d.Get("health_status").(string),
+	}
+	allIns, err := getInstancesInGroup(asClient, groupID, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	elements := make([]map[string]interface{}, len(allIns))
+	for i, ins := range allIns {
+		elements[i] = map[string]interface{}{
+			"instance_id":                ins.ID,
+			"instance_name":              ins.Name,
+			"scaling_configuration_id":   ins.ConfigurationID,
+			"scaling_configuration_name": ins.ConfigurationName,
+			"life_cycle_state":           ins.LifeCycleStatus,
+			"health_status":              ins.HealthStatus,
+			"protect_from_scaling_down":  ins.Protected,
+			"created_at":                 ins.CreateTime,
+		}
+	}
+
+	d.SetId(groupID)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instances", elements),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.Errorf("error setting AS instances fields: %s", mErr)
+	}
+	return nil
+}
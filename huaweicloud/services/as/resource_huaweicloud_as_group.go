@@ -41,6 +41,7 @@ func ResourceASGroup() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -224,7 +225,8 @@ func ResourceASGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": common.TagsSchema(),
+			"tags":             common.TagsSchema(),
+			"instance_refresh": instanceRefreshSchema(),
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -760,6 +762,18 @@ func resourceASGroupUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	// the instances are only refreshed when the AS group is enabled, since no instance is created in a disabled group
+	if d.HasChange("scaling_configuration_id") && d.Get("enable").(bool) {
+		if _, ok := d.GetOk("instance_refresh"); ok {
+			if err = refreshASGroupInstances(ctx, asClient, d); err != nil {
+				// Keep the previous configuration ID in the state, so that the next apply detects the change again
+				// and resumes refreshing the remaining instances.
+				d.Partial(true)
+				return diag.Errorf("error refreshing instances of AS group %s: %s", asgID, err)
+			}
+		}
+	}
+
 	return resourceASGroupRead(ctx, d, meta)
 }
 
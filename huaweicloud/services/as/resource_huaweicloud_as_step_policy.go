@@ -0,0 +1,304 @@
+package as
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceASStepPolicy manages a group of alarm policies, each step adjusts the instances of the AS group when the
+// metric breaches the threshold of the step.
+func ResourceASStepPolicy() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"scaling_group_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"scaling_policy_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.All(
+				// the suffixes of the step policy names take at most 8 characters
+				validation.StringLenBetween(1, 56),
+				validation.StringMatch(regexp.MustCompile("^[0-9a-zA-Z-_]+$"),
+					"only letters, digits, underscores (_), and hyphens (-) are allowed"),
+			),
+		},
+		"comparison_operator": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<="}, false),
+		},
+		"step_adjustment": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 10,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"threshold": {
+						Type:     schema.TypeFloat,
+						Required: true,
+					},
+					"operation": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(PolicyActions, false),
+					},
+					"instance_number": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"policy_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"alarm_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+	for k, v := range metricPolicySchemas() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceASStepPolicyCreate,
+		ReadContext:   resourceASStepPolicyRead,
+		UpdateContext: resourceASStepPolicyUpdate,
+		DeleteContext: resourceASStepPolicyDelete,
+
+		Schema: s,
+	}
+}
+
+func buildStepAdjustmentPolicy(d *schema.ResourceData, index int) metricAlarmPolicy {
+	step := d.Get(fmt.Sprintf("step_adjustment.%d", index)).(map[string]interface{})
+	return metricAlarmPolicy{
+		Name:        fmt.Sprintf("%s-step-%d", d.Get("scaling_policy_name").(string), index+1),
+		MetricName:  d.Get("metric_name").(string),
+		Operator:    d.Get("comparison_operator").(string),
+		Threshold:   step["threshold"].(float64),
+		Period:      d.Get("period").(int),
+		Count:       d.Get("evaluation_count").(int),
+		Operation:   step["operation"].(string),
+		InstanceNum: step["instance_number"].(int),
+		CoolDown:    d.Get("cool_down_time").(int),
+	}
+}
+
+// stepAdjustmentIds returns the IDs of the AS policies and CES alarm rules of the steps which are stored in the state.
+func stepAdjustmentIds(d *schema.ResourceData) (policyIds, alarmIds []string) {
+	oldRaw, _ := d.GetChange("step_adjustment")
+	for _, v := range oldRaw.([]interface{}) {
+		step := v.(map[string]interface{})
+		if step["policy_id"].(string) == "" {
+			continue
+		}
+		policyIds = append(policyIds, step["policy_id"].(string))
+		alarmIds = append(alarmIds, step["alarm_id"].(string))
+	}
+	return
+}
+
+// syncStepAdjustments updates the existing steps by position, creates the appended steps and deletes the redundant
+// steps.
+func syncStepAdjustments(asClient, cesClient *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	var (
+		groupID             = d.Get("scaling_group_id").(string)
+		stepNum             = len(d.Get("step_adjustment").([]interface{}))
+		policyIds, alarmIds = stepAdjustmentIds(d)
+		result              = make([]map[string]interface{}, 0, stepNum)
+		err                 error
+	)
+
+	for i := 0; i < stepNum; i++ {
+		opts := buildStepAdjustmentPolicy(d, i)
+		step := map[string]interface{}{
+			"threshold":       opts.Threshold,
+			"operation":       opts.Operation,
+			"instance_number": opts.InstanceNum,
+		}
+		if i < len(policyIds) {
+			step["policy_id"], step["alarm_id"] = policyIds[i], alarmIds[i]
+			err = updateMetricAlarmPolicy(asClient, cesClient, policyIds[i], alarmIds[i], opts)
+		} else {
+			step["policy_id"], step["alarm_id"], err = createMetricAlarmPolicy(asClient, cesClient, groupID, opts)
+		}
+		if err != nil {
+			break
+		}
+		result = append(result, step)
+	}
+	if err == nil {
+		for i := stepNum; i < len(policyIds); i++ {
+			if err = deleteMetricAlarmPolicy(asClient, cesClient, policyIds[i], alarmIds[i]); err != nil {
+				// the steps which are not deleted are kept in the state
+				for ; i < len(policyIds); i++ {
+					result = append(result, map[string]interface{}{
+						"policy_id": policyIds[i],
+						"alarm_id":  alarmIds[i],
+					})
+				}
+				break
+			}
+		}
+	} else {
+		// the steps which are not synchronized are kept in the state
+		for i := len(result); i < len(policyIds); i++ {
+			result = append(result, map[string]interface{}{
+				"policy_id": policyIds[i],
+				"alarm_id":  alarmIds[i],
+			})
+		}
+	}
+
+	if setErr := d.Set("step_adjustment", result); setErr != nil {
+		log.Printf("[WARN] failed to save the steps of AS step policy %s: %s", d.Id(), setErr)
+	}
+	return err
+}
+
+func resourceASStepPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	if err = syncStepAdjustments(asClient, cesClient, d); err != nil {
+		return diag.Errorf("error creating AS step policy: %s", err)
+	}
+	return resourceASStepPolicyRead(ctx, d, meta)
+}
+
+func resourceASStepPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	policyIds, alarmIds := stepAdjustmentIds(d)
+	steps := make([]map[string]interface{}, 0, len(policyIds))
+	mErr := multierror.Append(nil, d.Set("region", region))
+	for i, policyID := range policyIds {
+		policy, alarm, err := getMetricAlarmPolicy(asClient, cesClient, policyID, alarmIds[i])
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				log.Printf("[WARN] the step %s of AS step policy %s is gone", policyID, d.Id())
+				continue
+			}
+			return diag.Errorf("error retrieving the step %s of AS step policy %s: %s", policyID, d.Id(), err)
+		}
+		log.Printf("[DEBUG] Retrieved the step %s of AS step policy %s: %+v", policyID, d.Id(), policy)
+
+		steps = append(steps, map[string]interface{}{
+			"threshold":       alarm.Condition.Value,
+			"operation":       policy.Action.Operation,
+			"instance_number": policy.Action.InstanceNum,
+			"policy_id":       policyID,
+			"alarm_id":        alarmIds[i],
+			"status":          policy.Status,
+		})
+		// the common arguments are the same for all steps
+		if len(steps) == 1 {
+			mErr = multierror.Append(mErr,
+				d.Set("scaling_group_id", policy.ID),
+				d.Set("metric_name", alarm.Metric.MetricName),
+				d.Set("comparison_operator", alarm.Condition.ComparisonOperator),
+				d.Set("period", alarm.Condition.Period),
+				d.Set("evaluation_count", alarm.Condition.Count),
+				d.Set("cool_down_time", policy.CoolDownTime),
+			)
+		}
+	}
+	if len(steps) == 0 {
+		log.Printf("[WARN] all steps of AS step policy %s are gone, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	mErr = multierror.Append(mErr, d.Set("step_adjustment", steps))
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceASStepPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	if err = syncStepAdjustments(asClient, cesClient, d); err != nil {
+		return diag.Errorf("error updating AS step policy %s: %s", d.Id(), err)
+	}
+	return resourceASStepPolicyRead(ctx, d, meta)
+}
+
+func resourceASStepPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	policyIds, alarmIds := stepAdjustmentIds(d)
+	for i, policyID := range policyIds {
+		if err = deleteMetricAlarmPolicy(asClient, cesClient, policyID, alarmIds[i]); err != nil {
+			return diag.Errorf("error deleting AS step policy %s: %s", d.Id(), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package as
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceASTargetTrackingPolicy manages a pair of alarm policies which keep the metric of the AS group around the
+// target value: instances are added when the metric reaches the target value, and removed when the metric falls
+// below the scale-in threshold.
+func ResourceASTargetTrackingPolicy() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"scaling_group_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"scaling_policy_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.All(
+				// the suffixes of the alarm policy names take 10 characters
+				validation.StringLenBetween(1, 54),
+				validation.StringMatch(regexp.MustCompile("^[0-9a-zA-Z-_]+$"),
+					"only letters, digits, underscores (_), and hyphens (-) are allowed"),
+			),
+		},
+		"target_value": {
+			Type:     schema.TypeFloat,
+			Required: true,
+		},
+		"scale_in_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"scale_in_percentage": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      80,
+			ValidateFunc: validation.IntBetween(1, 99),
+		},
+		"instance_number": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"scale_out_policy_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"scale_out_alarm_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"scale_in_policy_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"scale_in_alarm_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+	for k, v := range metricPolicySchemas() {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceASTargetTrackingPolicyCreate,
+		ReadContext:   resourceASTargetTrackingPolicyRead,
+		UpdateContext: resourceASTargetTrackingPolicyUpdate,
+		DeleteContext: resourceASTargetTrackingPolicyDelete,
+
+		Schema: s,
+	}
+}
+
+func buildTargetTrackingPolicy(d *schema.ResourceData, scaleOut bool) metricAlarmPolicy {
+	opts := metricAlarmPolicy{
+		MetricName:  d.Get("metric_name").(string),
+		Period:      d.Get("period").(int),
+		Count:       d.Get("evaluation_count").(int),
+		InstanceNum: d.Get("instance_number").(int),
+		CoolDown:    d.Get("cool_down_time").(int),
+	}
+	targetValue := d.Get("target_value").(float64)
+	if scaleOut {
+		opts.Name = d.Get("scaling_policy_name").(string) + "-scale-out"
+		opts.Operator = ">="
+		opts.Threshold = targetValue
+		opts.Operation = "ADD"
+	} else {
+		opts.Name = d.Get("scaling_policy_name").(string) + "-scale-in"
+		opts.Operator = "<"
+		opts.Threshold = targetValue * float64(d.Get("scale_in_percentage").(int)) / 100
+		opts.Operation = "REMOVE"
+	}
+	return opts
+}
+
+func createTargetTrackingScaleInPolicy(d *schema.ResourceData, conf *config.Config, region string) error {
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return err
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return err
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	policyID, alarmID, err := createMetricAlarmPolicy(asClient, cesClient, groupID, buildTargetTrackingPolicy(d, false))
+	if err != nil {
+		return err
+	}
+	mErr := multierror.Append(nil,
+		d.Set("scale_in_policy_id", policyID),
+		d.Set("scale_in_alarm_id", alarmID),
+	)
+	return mErr.ErrorOrNil()
+}
+
+func resourceASTargetTrackingPolicyCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	groupID := d.Get("scaling_group_id").(string)
+	policyID, alarmID, err := createMetricAlarmPolicy(asClient, cesClient, groupID, buildTargetTrackingPolicy(d, true))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(policyID)
+	mErr := multierror.Append(nil,
+		d.Set("scale_out_policy_id", policyID),
+		d.Set("scale_out_alarm_id", alarmID),
+	)
+	if mErr.ErrorOrNil() != nil {
+		return diag.FromErr(mErr)
+	}
+
+	if d.Get("scale_in_enabled").(bool) {
+		if err = createTargetTrackingScaleInPolicy(d, conf, region); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceASTargetTrackingPolicyRead(ctx, d, meta)
+}
+
+func resourceASTargetTrackingPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	outPolicy, outAlarm, err := getMetricAlarmPolicy(asClient, cesClient, d.Id(), d.Get("scale_out_alarm_id").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "AS target tracking policy")
+	}
+	log.Printf("[DEBUG] Retrieved AS scale-out policy %s: %+v", d.Id(), outPolicy)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("scaling_group_id", outPolicy.ID),
+		d.Set("scaling_policy_name", strings.TrimSuffix(outPolicy.Name, "-scale-out")),
+		d.Set("metric_name", outAlarm.Metric.MetricName),
+		d.Set("target_value", outAlarm.Condition.Value),
+		d.Set("period", outAlarm.Condition.Period),
+		d.Set("evaluation_count", outAlarm.Condition.Count),
+		d.Set("instance_number", outPolicy.Action.InstanceNum),
+		d.Set("cool_down_time", outPolicy.CoolDownTime),
+		d.Set("status", outPolicy.Status),
+	)
+
+	scaleInEnabled := false
+	if policyID := d.Get("scale_in_policy_id").(string); policyID != "" {
+		inPolicy, _, err := getMetricAlarmPolicy(asClient, cesClient, policyID, d.Get("scale_in_alarm_id").(string))
+		if err == nil {
+			scaleInEnabled = true
+			log.Printf("[DEBUG] Retrieved AS scale-in policy %s: %+v", policyID, inPolicy)
+		} else if _, ok := err.(golangsdk.ErrDefault404); ok {
+			mErr = multierror.Append(mErr,
+				d.Set("scale_in_policy_id", ""),
+				d.Set("scale_in_alarm_id", ""),
+			)
+		} else {
+			return diag.Errorf("error retrieving AS scale-in policy %s: %s", policyID, err)
+		}
+	}
+	mErr = multierror.Append(mErr, d.Set("scale_in_enabled", scaleInEnabled))
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceASTargetTrackingPolicyUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	err = updateMetricAlarmPolicy(asClient, cesClient, d.Id(), d.Get("scale_out_alarm_id").(string),
+		buildTargetTrackingPolicy(d, true))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	inPolicyID := d.Get("scale_in_policy_id").(string)
+	inAlarmID := d.Get("scale_in_alarm_id").(string)
+	switch {
+	case d.Get("scale_in_enabled").(bool) && inPolicyID == "":
+		err = createTargetTrackingScaleInPolicy(d, conf, region)
+	case d.Get("scale_in_enabled").(bool):
+		err = updateMetricAlarmPolicy(asClient, cesClient, inPolicyID, inAlarmID, buildTargetTrackingPolicy(d, false))
+	case inPolicyID != "":
+		if err = deleteMetricAlarmPolicy(asClient, cesClient, inPolicyID, inAlarmID); err == nil {
+			mErr := multierror.Append(nil,
+				d.Set("scale_in_policy_id", ""),
+				d.Set("scale_in_alarm_id", ""),
+			)
+			err = mErr.ErrorOrNil()
+		}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceASTargetTrackingPolicyRead(ctx, d, meta)
+}
+
+func resourceASTargetTrackingPolicyDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	conf := meta.(*config.Config)
+	region := conf.GetRegion(d)
+	asClient, err := conf.AutoscalingV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating autoscaling client: %s", err)
+	}
+	cesClient, err := conf.CesV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CES client: %s", err)
+	}
+
+	err = deleteMetricAlarmPolicy(asClient, cesClient, d.Get("scale_in_policy_id").(string),
+		d.Get("scale_in_alarm_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = deleteMetricAlarmPolicy(asClient, cesClient, d.Id(), d.Get("scale_out_alarm_id").(string))
+	return diag.FromErr(err)
+}
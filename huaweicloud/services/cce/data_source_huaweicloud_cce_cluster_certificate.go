@@ -0,0 +1,215 @@
+package cce
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/cce/v3/clusters"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceCCEClusterCertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCCEClusterCertificateRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.Any(validation.IntInSlice([]int{-1}), validation.IntBetween(1, 1825)),
+			},
+			"context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "external",
+				ValidateFunc: validation.StringInSlice([]string{
+					"internal", "external", "externalTLSVerify",
+				}, false),
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"insecure": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expiry_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// certificateClusterConfig is the cluster entry of the kubeconfig, the certificate data source needs the
+// insecure-skip-tls-verify flag which is not contained in clusters.CertCluster.
+type certificateClusterConfig struct {
+	Server                string `json:"server"`
+	CertAuthorityData     string `json:"certificate-authority-data"`
+	InsecureSkipTLSVerify bool   `json:"insecure-skip-tls-verify"`
+}
+
+type certificateKubeConfig struct {
+	Clusters []struct {
+		Name    string                   `json:"name"`
+		Cluster certificateClusterConfig `json:"cluster"`
+	} `json:"clusters"`
+	Users    []clusters.CertUsers    `json:"users"`
+	Contexts []clusters.CertContexts `json:"contexts"`
+}
+
+func decodeCertificateData(data string) (string, error) {
+	if data == "" {
+		return "", nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+func parseCertificateExpiryDate(certPem string) (string, error) {
+	block, _ := pem.Decode([]byte(certPem))
+	if block == nil {
+		return "", fmt.Errorf("the client certificate is not a valid PEM data")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	return cert.NotAfter.UTC().Format(time.RFC3339), nil
+}
+
+func dataSourceCCEClusterCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CceV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CCE v3 client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	var kubeConfigRaw json.RawMessage
+	createCertPath := client.ServiceURL("clusters", clusterID, "clustercert")
+	createCertOpt := golangsdk.RequestOpts{
+		OkCodes:      []int{200, 201},
+		JSONResponse: &kubeConfigRaw,
+		JSONBody: map[string]interface{}{
+			"duration": d.Get("duration").(int),
+		},
+	}
+	_, err = client.Request("POST", createCertPath, &createCertOpt)
+	if err != nil {
+		return diag.Errorf("error creating the certificate of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	var kubeConfig certificateKubeConfig
+	if err = json.Unmarshal(kubeConfigRaw, &kubeConfig); err != nil {
+		return diag.Errorf("error decoding the certificate of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	contextName := d.Get("context").(string)
+	var clusterName, userName string
+	for _, c := range kubeConfig.Contexts {
+		if c.Name == contextName {
+			clusterName = c.Context.Cluster
+			userName = c.Context.User
+			break
+		}
+	}
+	if clusterName == "" {
+		return diag.Errorf("the context (%s) is not found in the certificate of CCE cluster (%s), "+
+			"the external contexts are only available when the cluster is bound with an EIP", contextName, clusterID)
+	}
+
+	var clusterConfig certificateClusterConfig
+	for _, c := range kubeConfig.Clusters {
+		if c.Name == clusterName {
+			clusterConfig = c.Cluster
+			break
+		}
+	}
+	var userConfig clusters.CertUser
+	for _, u := range kubeConfig.Users {
+		if u.Name == userName {
+			userConfig = u.User
+			break
+		}
+	}
+
+	caCert, err := decodeCertificateData(clusterConfig.CertAuthorityData)
+	if err != nil {
+		return diag.Errorf("error decoding the CA certificate of CCE cluster (%s): %s", clusterID, err)
+	}
+	clientCert, err := decodeCertificateData(userConfig.ClientCertData)
+	if err != nil {
+		return diag.Errorf("error decoding the client certificate of CCE cluster (%s): %s", clusterID, err)
+	}
+	clientKey, err := decodeCertificateData(userConfig.ClientKeyData)
+	if err != nil {
+		return diag.Errorf("error decoding the client key of CCE cluster (%s): %s", clusterID, err)
+	}
+	expiryDate, err := parseCertificateExpiryDate(clientCert)
+	if err != nil {
+		return diag.Errorf("error parsing the client certificate of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterID, contextName))
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("host", clusterConfig.Server),
+		d.Set("cluster_ca_certificate", caCert),
+		d.Set("client_certificate", clientCert),
+		d.Set("client_key", clientKey),
+		d.Set("insecure", clusterConfig.InsecureSkipTLSVerify),
+		d.Set("expiry_date", expiryDate),
+		d.Set("kube_config_raw", string(kubeConfigRaw)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting the certificate fields of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	return nil
+}
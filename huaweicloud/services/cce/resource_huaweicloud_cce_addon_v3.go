@@ -25,6 +25,7 @@ func ResourceCCEAddonV3() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCCEAddonV3Create,
 		ReadContext:   resourceCCEAddonV3Read,
+		UpdateContext: resourceCCEAddonV3Update,
 		DeleteContext: resourceCCEAddonV3Delete,
 
 		Importer: &schema.ResourceImporter{
@@ -33,6 +34,7 @@ func ResourceCCEAddonV3() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(3 * time.Minute),
 		},
 
@@ -51,7 +53,6 @@ func ResourceCCEAddonV3() *schema.Resource {
 			"version": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"template_name": {
 				Type:     schema.TypeString,
@@ -69,63 +70,50 @@ func ResourceCCEAddonV3() *schema.Resource {
 			"values": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"basic": {
-							Type:         schema.TypeMap,
-							Optional:     true,
-							ForceNew:     true,
-							Elem:         &schema.Schema{Type: schema.TypeString},
-							ExactlyOneOf: []string{"values.0.basic", "values.0.basic_json"},
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ExactlyOneOf:     []string{"values.0.basic", "values.0.basic_json"},
+							DiffSuppressFunc: suppressEquivalentAddonValues,
 						},
 						"basic_json": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.StringIsJSON,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
-								return equal
-							},
-							ExactlyOneOf: []string{"values.0.basic", "values.0.basic_json"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentAddonValues,
+							ExactlyOneOf:     []string{"values.0.basic", "values.0.basic_json"},
 						},
 						"custom": {
-							Type:          schema.TypeMap,
-							Optional:      true,
-							ForceNew:      true,
-							Elem:          &schema.Schema{Type: schema.TypeString},
-							ConflictsWith: []string{"values.0.custom_json"},
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ConflictsWith:    []string{"values.0.custom_json"},
+							DiffSuppressFunc: suppressEquivalentAddonValues,
 						},
 						"custom_json": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.StringIsJSON,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
-								return equal
-							},
-							ConflictsWith: []string{"values.0.custom"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentAddonValues,
+							ConflictsWith:    []string{"values.0.custom"},
 						},
 						"flavor": {
-							Type:          schema.TypeMap,
-							Optional:      true,
-							ForceNew:      true,
-							Elem:          &schema.Schema{Type: schema.TypeString},
-							ConflictsWith: []string{"values.0.flavor_json"},
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ConflictsWith:    []string{"values.0.flavor_json"},
+							DiffSuppressFunc: suppressEquivalentAddonValues,
 						},
 						"flavor_json": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.StringIsJSON,
-							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-								equal, _ := utils.CompareJsonTemplateAreEquivalent(old, new)
-								return equal
-							},
-							ConflictsWith: []string{"values.0.flavor"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentAddonValues,
+							ConflictsWith:    []string{"values.0.flavor"},
 						},
 					},
 				},
@@ -134,8 +122,7 @@ func ResourceCCEAddonV3() *schema.Resource {
 	}
 }
 
-func getValuesValues(d *schema.ResourceData) (basic, custom, flavor map[string]interface{}, err error) {
-	values := d.Get("values").([]interface{})
+func getValuesValues(values []interface{}) (basic, custom, flavor map[string]interface{}, err error) {
 	if len(values) == 0 {
 		basic = map[string]interface{}{}
 		return
@@ -187,7 +174,7 @@ func resourceCCEAddonV3Create(ctx context.Context, d *schema.ResourceData, meta
 
 	var cluster_id = d.Get("cluster_id").(string)
 
-	basic, custom, flavor, err := getValuesValues(d)
+	basic, custom, flavor, err := getValuesValues(d.Get("values").([]interface{}))
 	if err != nil {
 		return fmtp.DiagErrorf("error getting values for CCE addon: %s", err)
 	}
@@ -266,6 +253,121 @@ func resourceCCEAddonV3Read(_ context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
+// buildAddonValuesJson merges the basic, custom and flavor parameters into a single JSON string, which is used to
+// compare the add-on parameters regardless of whether they are specified by maps or JSON strings.
+func buildAddonValuesJson(values []interface{}) (string, error) {
+	basic, custom, flavor, err := getValuesValues(values)
+	if err != nil {
+		return "", err
+	}
+
+	merged, err := json.Marshal(addons.Values{
+		Basic:  basic,
+		Custom: custom,
+		Flavor: flavor,
+	})
+	if err != nil {
+		return "", fmtp.Errorf("Error marshalling values: %s", err)
+	}
+	return string(merged), nil
+}
+
+func addonValuesAreEquivalent(oldValues, newValues []interface{}) (bool, error) {
+	oldJson, err := buildAddonValuesJson(oldValues)
+	if err != nil {
+		return false, err
+	}
+	newJson, err := buildAddonValuesJson(newValues)
+	if err != nil {
+		return false, err
+	}
+	return utils.CompareJsonTemplateAreEquivalent(oldJson, newJson)
+}
+
+// suppressEquivalentAddonValues compares the merged add-on parameters instead of each single field, so that
+// moving a parameter between the map and JSON forms, or reformatting the JSON, does not cause an update.
+func suppressEquivalentAddonValues(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	oldRaw, newRaw := d.GetChange("values")
+	equal, err := addonValuesAreEquivalent(oldRaw.([]interface{}), newRaw.([]interface{}))
+	if err != nil {
+		// Fall back to the comparison of the single JSON field.
+		equal, _ = utils.CompareJsonTemplateAreEquivalent(old, new)
+	}
+	return equal
+}
+
+func buildAddonUpdateBodyParams(d *schema.ResourceData, basic, custom, flavor map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"kind":       "Addon",
+		"apiVersion": "v3",
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				"addon.upgrade/type": "upgrade",
+			},
+		},
+		"spec": map[string]interface{}{
+			"clusterID":         d.Get("cluster_id"),
+			"version":           d.Get("version"),
+			"addonTemplateName": d.Get("template_name"),
+			"values": addons.Values{
+				Basic:  basic,
+				Custom: custom,
+				Flavor: flavor,
+			},
+		},
+	}
+}
+
+func resourceCCEAddonV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*config.Config)
+	cceClient, err := config.CceAddonV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloud CCE client: %s", err)
+	}
+
+	if !d.HasChanges("version", "values") {
+		return resourceCCEAddonV3Read(ctx, d, meta)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	basic, custom, flavor, err := getValuesValues(d.Get("values").([]interface{}))
+	if err != nil {
+		return fmtp.DiagErrorf("error getting values for CCE addon: %s", err)
+	}
+
+	updatePath := addons.CCEServiceURL(cceClient, clusterID, "addons", d.Id()+"?cluster_id="+clusterID)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody:         buildAddonUpdateBodyParams(d, basic, custom, flavor),
+	}
+	_, err = cceClient.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return fmtp.DiagErrorf("Error updating HuaweiCloud CCE Addon (%s): %s", d.Id(), err)
+	}
+
+	logp.Printf("[DEBUG] Waiting for HuaweiCloud CCEAddon (%s) to become available", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"installing", "upgrading"},
+		Target:       []string{"running", "available"},
+		Refresh:      waitForCCEAddonUpdated(cceClient, d.Id(), clusterID),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmtp.DiagErrorf("Error waiting for HuaweiCloud CCE Addon (%s) to be updated: %s", d.Id(), err)
+	}
+
+	return resourceCCEAddonV3Read(ctx, d, meta)
+}
+
 func resourceCCEAddonV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	cceClient, err := config.CceAddonV3Client(config.GetRegion(d))
@@ -309,6 +411,23 @@ func waitForCCEAddonActive(cceAddonClient *golangsdk.ServiceClient, id, clusterI
 	}
 }
 
+// waitForCCEAddonUpdated fails fast when the add-on becomes abnormal, which means the upgrade or the values change
+// has failed and will not recover by itself.
+func waitForCCEAddonUpdated(cceAddonClient *golangsdk.ServiceClient, id, clusterID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		n, err := addons.Get(cceAddonClient, id, clusterID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if n.Status.Status == "abnormal" {
+			return n, n.Status.Status, fmtp.Errorf("the add-on is abnormal, reason: %s, message: %s",
+				n.Status.Reason, n.Status.Message)
+		}
+		return n, n.Status.Status, nil
+	}
+}
+
 func waitForCCEAddonDelete(cceClient *golangsdk.ServiceClient, id, clusterID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		logp.Printf("[DEBUG] Attempting to delete HuaweiCloud CCE Addon %s.\n", id)
@@ -0,0 +1,414 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceAutoscalingPolicy is the impl of huaweicloud_cce_autoscaling_policy, which manages the node scaling policy
+// of the node pools. The policy is executed by the autoscaler add-on of the cluster.
+func ResourceAutoscalingPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAutoscalingPolicyCreate,
+		ReadContext:   resourceAutoscalingPolicyRead,
+		UpdateContext: resourceAutoscalingPolicyUpdate,
+		DeleteContext: resourceAutoscalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAutoscalingPolicyImportState,
+		},
+
+		CustomizeDiff: resourceAutoscalingPolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the CCE cluster.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the scaling policy.`,
+			},
+			"node_pool_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the IDs of the node pools to which the policy applies.`,
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the name of the scaling rule.`,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Metric", "Period"}, false),
+							Description:  `Specifies the type of the scaling rule.`,
+						},
+						"metric_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"CpuAllocationRate", "MemoryAllocationRate",
+							}, false),
+							Description: `Specifies the metric which triggers the scaling rule.`,
+						},
+						"metric_operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{">", "<"}, false),
+							Description:  `Specifies the comparison operator of the metric.`,
+						},
+						"metric_value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the threshold of the metric, in percentage.`,
+						},
+						"schedule": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the cron expression at which the scaling rule is triggered.`,
+						},
+						"action_unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Node",
+							ValidateFunc: validation.StringInSlice([]string{"Node", "Percent"}, false),
+							Description:  `Specifies the unit of the scaling action.`,
+						},
+						"action_value": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Specifies the number or the percentage of nodes to add.`,
+						},
+					},
+				},
+			},
+			"scale_down": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: `Specifies whether the nodes of the node pools can be scaled down.`,
+						},
+						"utilization_threshold": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 1),
+							Description:  `Specifies the resource allocation rate below which a node can be removed.`,
+						},
+						"unneeded_time": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: `Specifies how long a node must be unneeded before it is removed, in minutes.`,
+						},
+						"delay_after_add": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: `Specifies how long after a scale-up the scale-down evaluation resumes, in minutes.`,
+						},
+					},
+				},
+			},
+			"expander": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"priority", "random", "least-waste", "most-pods",
+				}, false),
+				Description: `Specifies how the node pool to scale up is selected.`,
+			},
+		},
+	}
+}
+
+// autoscalingPolicyRuleRequiredKeys are the parameters which are required by each type of the scaling rules.
+var autoscalingPolicyRuleRequiredKeys = map[string][]string{
+	"Metric": {"metric_name", "metric_operator", "metric_value"},
+	"Period": {"schedule"},
+}
+
+func resourceAutoscalingPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	var mErr *multierror.Error
+	for i, v := range d.Get("rules").([]interface{}) {
+		rule, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ruleType := rule["type"].(string)
+		for _, key := range autoscalingPolicyRuleRequiredKeys[ruleType] {
+			// the values which are only known after apply are not checked
+			if !d.NewValueKnown(fmt.Sprintf("rules.%d.%s", i, key)) {
+				continue
+			}
+			if rule[key].(string) == "" {
+				mErr = multierror.Append(mErr, fmt.Errorf("rules.%d.%s is required when the rule type is %s",
+					i, key, ruleType))
+			}
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+func buildAutoscalingPolicyPath(client *golangsdk.ServiceClient, clusterId, policyId string) string {
+	path := client.Endpoint + "api/v2/projects/{project_id}/clusters/{cluster_id}/autoscalingpolicies"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{cluster_id}", clusterId)
+	if policyId != "" {
+		path = path + "/" + policyId
+	}
+	return path
+}
+
+func buildAutoscalingPolicyRulesParams(rules []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, v := range rules {
+		rule := v.(map[string]interface{})
+		params := map[string]interface{}{
+			"ruleName": rule["name"],
+			"type":     rule["type"],
+			"action": map[string]interface{}{
+				"unit":  rule["action_unit"],
+				"value": rule["action_value"],
+			},
+		}
+		if rule["type"].(string) == "Metric" {
+			params["metricTrigger"] = map[string]interface{}{
+				"metricName":      rule["metric_name"],
+				"metricOperation": rule["metric_operator"],
+				"metricValue":     rule["metric_value"],
+			}
+		} else {
+			params["cronTrigger"] = map[string]interface{}{
+				"schedule": rule["schedule"],
+			}
+		}
+		result[i] = params
+	}
+	return result
+}
+
+func buildAutoscalingPolicyScaleDownParams(scaleDowns []interface{}) map[string]interface{} {
+	if len(scaleDowns) == 0 || scaleDowns[0] == nil {
+		return nil
+	}
+
+	scaleDown := scaleDowns[0].(map[string]interface{})
+	return map[string]interface{}{
+		"enable":               scaleDown["enabled"],
+		"utilizationThreshold": utils.ValueIngoreEmpty(scaleDown["utilization_threshold"]),
+		"unneededTime":         utils.ValueIngoreEmpty(scaleDown["unneeded_time"]),
+		"delayAfterAdd":        utils.ValueIngoreEmpty(scaleDown["delay_after_add"]),
+	}
+}
+
+func buildAutoscalingPolicyBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"kind":       "AutoScalingPolicy",
+		"apiVersion": "v2",
+		"metadata": map[string]interface{}{
+			"name": d.Get("name"),
+		},
+		"spec": map[string]interface{}{
+			"nodePoolIDs": utils.ExpandToStringList(d.Get("node_pool_ids").([]interface{})),
+			"rules":       buildAutoscalingPolicyRulesParams(d.Get("rules").([]interface{})),
+			"scaleDown":   buildAutoscalingPolicyScaleDownParams(d.Get("scale_down").([]interface{})),
+			"expander":    utils.ValueIngoreEmpty(d.Get("expander")),
+		},
+	}
+}
+
+func resourceAutoscalingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cce", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201,
+		},
+		JSONBody: utils.RemoveNil(buildAutoscalingPolicyBodyParams(d)),
+	}
+	createResp, err := client.Request("POST", buildAutoscalingPolicyPath(client, d.Get("cluster_id").(string), ""),
+		&createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CCE autoscaling policy: %s", err)
+	}
+
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("metadata.uid", createRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the CCE autoscaling policy ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceAutoscalingPolicyRead(ctx, d, meta)
+}
+
+func flattenAutoscalingPolicyRules(respBody interface{}) []map[string]interface{} {
+	rules := utils.PathSearch("spec.rules", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(rules))
+	for i, v := range rules {
+		result[i] = map[string]interface{}{
+			"name":            utils.PathSearch("ruleName", v, nil),
+			"type":            utils.PathSearch("type", v, nil),
+			"metric_name":     utils.PathSearch("metricTrigger.metricName", v, nil),
+			"metric_operator": utils.PathSearch("metricTrigger.metricOperation", v, nil),
+			"metric_value":    utils.PathSearch("metricTrigger.metricValue", v, nil),
+			"schedule":        utils.PathSearch("cronTrigger.schedule", v, nil),
+			"action_unit":     utils.PathSearch("action.unit", v, nil),
+			"action_value":    utils.PathSearch("action.value", v, nil),
+		}
+	}
+	return result
+}
+
+func flattenAutoscalingPolicyScaleDown(respBody interface{}) []map[string]interface{} {
+	scaleDown := utils.PathSearch("spec.scaleDown", respBody, nil)
+	if scaleDown == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"enabled":               utils.PathSearch("enable", scaleDown, false),
+			"utilization_threshold": utils.PathSearch("utilizationThreshold", scaleDown, nil),
+			"unneeded_time":         utils.PathSearch("unneededTime", scaleDown, nil),
+			"delay_after_add":       utils.PathSearch("delayAfterAdd", scaleDown, nil),
+		},
+	}
+}
+
+func resourceAutoscalingPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cce", region)
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", buildAutoscalingPolicyPath(client, d.Get("cluster_id").(string), d.Id()),
+		&getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE autoscaling policy")
+	}
+
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("metadata.name", getRespBody, nil)),
+		d.Set("node_pool_ids", utils.PathSearch("spec.nodePoolIDs", getRespBody, nil)),
+		d.Set("rules", flattenAutoscalingPolicyRules(getRespBody)),
+		d.Set("scale_down", flattenAutoscalingPolicyScaleDown(getRespBody)),
+		d.Set("expander", utils.PathSearch("spec.expander", getRespBody, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceAutoscalingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cce", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(buildAutoscalingPolicyBodyParams(d)),
+	}
+	_, err = client.Request("PUT", buildAutoscalingPolicyPath(client, d.Get("cluster_id").(string), d.Id()),
+		&updateOpt)
+	if err != nil {
+		return diag.Errorf("error updating CCE autoscaling policy (%s): %s", d.Id(), err)
+	}
+	return resourceAutoscalingPolicyRead(ctx, d, meta)
+}
+
+func resourceAutoscalingPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cce", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	_, err = client.Request("DELETE", buildAutoscalingPolicyPath(client, d.Get("cluster_id").(string), d.Id()),
+		&deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE autoscaling policy")
+	}
+	return nil
+}
+
+func resourceAutoscalingPolicyImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for CCE autoscaling policy, must be <cluster_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("cluster_id", parts[0])
+}
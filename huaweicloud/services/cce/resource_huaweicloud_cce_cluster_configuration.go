@@ -0,0 +1,266 @@
+package cce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const clusterConfigurationHttpUrl = "api/v3/projects/{project_id}/clusters/{cluster_id}/nodepools/master/configuration"
+
+func ResourceClusterConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterConfigurationCreate,
+		ReadContext:   resourceClusterConfigurationRead,
+		UpdateContext: resourceClusterConfigurationUpdate,
+		DeleteContext: resourceClusterConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the CCE cluster.`,
+			},
+			"packages": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the name of the master component.`,
+						},
+						"configurations": {
+							Type:        schema.TypeMap,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `Specifies the parameters of the master component.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildClusterConfigurationPath(client *golangsdk.ServiceClient, clusterId string) string {
+	path := client.Endpoint + clusterConfigurationHttpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{cluster_id}", clusterId)
+	return path
+}
+
+// parseConfigurationValue converts the string value of the parameter to the type required by the API, e.g. "300"
+// to 300 and "true" to true. The value which is not a valid JSON is used as a plain string.
+func parseConfigurationValue(value string) interface{} {
+	var result interface{}
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		return value
+	}
+	return result
+}
+
+func flattenConfigurationValue(value interface{}) string {
+	if v, ok := value.(string); ok {
+		return v
+	}
+	result, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(result)
+}
+
+func buildClusterConfigurationBodyParams(d *schema.ResourceData) map[string]interface{} {
+	packagesRaw := d.Get("packages").(*schema.Set).List()
+	packages := make([]map[string]interface{}, 0, len(packagesRaw))
+	for _, v := range packagesRaw {
+		pkg := v.(map[string]interface{})
+		configurationsRaw := pkg["configurations"].(map[string]interface{})
+		configurations := make([]map[string]interface{}, 0, len(configurationsRaw))
+		for name, value := range configurationsRaw {
+			configurations = append(configurations, map[string]interface{}{
+				"name":  name,
+				"value": parseConfigurationValue(value.(string)),
+			})
+		}
+		packages = append(packages, map[string]interface{}{
+			"name":           pkg["name"],
+			"configurations": configurations,
+		})
+	}
+
+	return map[string]interface{}{
+		"apiVersion": "v3",
+		"kind":       "Configuration",
+		"metadata": map[string]interface{}{
+			"name": "configuration",
+		},
+		"spec": map[string]interface{}{
+			"packages": packages,
+		},
+	}
+}
+
+func updateClusterConfiguration(ctx context.Context, d *schema.ResourceData, cfg *config.Config, clusterId string,
+	timeout time.Duration) error {
+	client, err := cfg.NewServiceClient("cce", cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CCE Client: %s", err)
+	}
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: buildClusterConfigurationBodyParams(d),
+	}
+	_, err = client.Request("PUT", buildClusterConfigurationPath(client, clusterId), &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating the configuration of CCE cluster (%s): %s", clusterId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"Available"},
+		Refresh:    waitForClusterAvailable(client, clusterId),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for CCE cluster (%s) to be Available: %s", clusterId, err)
+	}
+	return nil
+}
+
+func resourceClusterConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	clusterId := d.Get("cluster_id").(string)
+
+	if err := updateClusterConfiguration(ctx, d, cfg, clusterId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterId)
+	return resourceClusterConfigurationRead(ctx, d, meta)
+}
+
+func resourceClusterConfigurationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cce", region)
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", buildClusterConfigurationPath(client, d.Id()), &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE cluster configuration")
+	}
+
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", d.Id()),
+		d.Set("packages", flattenClusterConfigurationPackages(d, getRespBody)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// flattenClusterConfigurationPackages only sets the parameters which are specified, because the API returns all
+// parameters of each master component. All parameters are set when the resource is imported.
+func flattenClusterConfigurationPackages(d *schema.ResourceData, respBody interface{}) []map[string]interface{} {
+	specified := make(map[string]map[string]interface{})
+	for _, v := range d.Get("packages").(*schema.Set).List() {
+		pkg := v.(map[string]interface{})
+		specified[pkg["name"].(string)] = pkg["configurations"].(map[string]interface{})
+	}
+
+	packagesRaw := utils.PathSearch("spec.packages", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, 0, len(packagesRaw))
+	for _, pkg := range packagesRaw {
+		name := utils.PathSearch("name", pkg, "").(string)
+		specifiedConfigurations, ok := specified[name]
+		if len(specified) > 0 && !ok {
+			continue
+		}
+
+		configurations := make(map[string]interface{})
+		configurationsRaw := utils.PathSearch("configurations", pkg, make([]interface{}, 0)).([]interface{})
+		for _, c := range configurationsRaw {
+			configName := utils.PathSearch("name", c, "").(string)
+			if _, ok := specifiedConfigurations[configName]; len(specified) > 0 && !ok {
+				continue
+			}
+			configurations[configName] = flattenConfigurationValue(utils.PathSearch("value", c, nil))
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":           name,
+			"configurations": configurations,
+		})
+	}
+	return result
+}
+
+func resourceClusterConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	if d.HasChange("packages") {
+		if err := updateClusterConfiguration(ctx, d, cfg, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceClusterConfigurationRead(ctx, d, meta)
+}
+
+func resourceClusterConfigurationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting CCE cluster configuration is not supported. The configuration is only removed from the " +
+		"state, but it remains in the cloud."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
@@ -0,0 +1,256 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const clusterLogConfigHttpUrl = "api/v3/projects/{project_id}/cluster/{cluster_id}/log-configs"
+
+func ResourceClusterLogConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceClusterLogConfigCreate,
+		ReadContext:   resourceClusterLogConfigRead,
+		UpdateContext: resourceClusterLogConfigUpdate,
+		DeleteContext: resourceClusterLogConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the CCE cluster.`,
+			},
+			"ttl_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 30),
+				Description:  `Specifies the storage days of the logs.`,
+			},
+			"log_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"log_stream_id"},
+				Description:  `Specifies the ID of the LTS log group to which the logs are reported.`,
+			},
+			"log_stream_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"log_group_id"},
+				Description:  `Specifies the ID of the LTS log stream to which the logs are reported.`,
+			},
+			"log_configs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the name of the log type.`,
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: `Specifies whether to collect the logs.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the log, e.g. control and audit.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildClusterLogConfigPath(client *golangsdk.ServiceClient, clusterId string) string {
+	path := client.Endpoint + clusterLogConfigHttpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{cluster_id}", clusterId)
+	return path
+}
+
+func buildClusterLogConfigBodyParams(d *schema.ResourceData, enabled bool, removed []string) map[string]interface{} {
+	logConfigsRaw := d.Get("log_configs").(*schema.Set).List()
+	logConfigs := make([]map[string]interface{}, 0, len(logConfigsRaw)+len(removed))
+	for _, v := range logConfigsRaw {
+		logConfig := v.(map[string]interface{})
+		logConfigs = append(logConfigs, map[string]interface{}{
+			"name":   logConfig["name"],
+			"enable": enabled && logConfig["enable"].(bool),
+		})
+	}
+	for _, name := range removed {
+		logConfigs = append(logConfigs, map[string]interface{}{
+			"name":   name,
+			"enable": false,
+		})
+	}
+
+	return map[string]interface{}{
+		"ttl_in_days":   utils.ValueIngoreEmpty(d.Get("ttl_in_days")),
+		"log_group_id":  utils.ValueIngoreEmpty(d.Get("log_group_id")),
+		"log_stream_id": utils.ValueIngoreEmpty(d.Get("log_stream_id")),
+		"log_configs":   logConfigs,
+	}
+}
+
+// updateClusterLogConfig updates the log configurations of the cluster, all specified log types are disabled when
+// enabled is false, and the removed log types are always disabled.
+func updateClusterLogConfig(d *schema.ResourceData, cfg *config.Config, clusterId string, enabled bool,
+	removed []string) error {
+	client, err := cfg.NewServiceClient("cce", cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CCE Client: %s", err)
+	}
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(buildClusterLogConfigBodyParams(d, enabled, removed)),
+	}
+	_, err = client.Request("PUT", buildClusterLogConfigPath(client, clusterId), &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating the log configurations of CCE cluster (%s): %s", clusterId, err)
+	}
+	return nil
+}
+
+func resourceClusterLogConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	clusterId := d.Get("cluster_id").(string)
+
+	if err := updateClusterLogConfig(d, cfg, clusterId, true, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterId)
+	return resourceClusterLogConfigRead(ctx, d, meta)
+}
+
+func resourceClusterLogConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cce", region)
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", buildClusterLogConfigPath(client, d.Id()), &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE cluster log configurations")
+	}
+
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", d.Id()),
+		d.Set("ttl_in_days", utils.PathSearch("ttl_in_days", getRespBody, nil)),
+		d.Set("log_group_id", utils.PathSearch("log_group_id", getRespBody, nil)),
+		d.Set("log_stream_id", utils.PathSearch("log_stream_id", getRespBody, nil)),
+		d.Set("log_configs", flattenClusterLogConfigs(d, getRespBody)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// flattenClusterLogConfigs only sets the log types which are specified, all log types are set when the resource
+// is imported.
+func flattenClusterLogConfigs(d *schema.ResourceData, respBody interface{}) []map[string]interface{} {
+	specified := make(map[string]bool)
+	for _, v := range d.Get("log_configs").(*schema.Set).List() {
+		specified[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	logConfigsRaw := utils.PathSearch("log_configs", respBody, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, 0, len(logConfigsRaw))
+	for _, v := range logConfigsRaw {
+		name := utils.PathSearch("name", v, "").(string)
+		if len(specified) > 0 && !specified[name] {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":   name,
+			"enable": utils.PathSearch("enable", v, false),
+			"type":   utils.PathSearch("type", v, nil),
+		})
+	}
+	return result
+}
+
+// getRemovedClusterLogTypes returns the names of the log types which are removed from log_configs.
+func getRemovedClusterLogTypes(d *schema.ResourceData) []string {
+	oRaw, nRaw := d.GetChange("log_configs")
+	names := make(map[string]bool)
+	for _, v := range nRaw.(*schema.Set).List() {
+		names[v.(map[string]interface{})["name"].(string)] = true
+	}
+
+	removed := make([]string, 0)
+	for _, v := range oRaw.(*schema.Set).List() {
+		if name := v.(map[string]interface{})["name"].(string); !names[name] {
+			removed = append(removed, name)
+		}
+	}
+	return removed
+}
+
+func resourceClusterLogConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	if d.HasChanges("ttl_in_days", "log_group_id", "log_stream_id", "log_configs") {
+		if err := updateClusterLogConfig(d, cfg, d.Id(), true, getRemovedClusterLogTypes(d)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceClusterLogConfigRead(ctx, d, meta)
+}
+
+func resourceClusterLogConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	// Disable the collection of all specified log types.
+	if err := updateClusterLogConfig(d, cfg, d.Id(), false, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling CCE cluster log configurations")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package cce
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -34,9 +35,12 @@ func ResourceCCENodePool() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: resourceCCENodePoolCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"region": {
 				Type:     schema.TypeString,
@@ -60,7 +64,6 @@ func ResourceCCENodePool() *schema.Resource {
 			"flavor_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -76,85 +79,71 @@ func ResourceCCENodePool() *schema.Resource {
 			"root_volume": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"size": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"volumetype": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"hw_passthrough": {
 							Type:        schema.TypeBool,
 							Optional:    true,
-							ForceNew:    true,
 							Description: "schema: Internal",
 						},
 						"extend_param": {
 							Type:       schema.TypeString,
 							Optional:   true,
-							ForceNew:   true,
 							Deprecated: "use extend_params instead",
 						},
 						"extend_params": {
 							Type:     schema.TypeMap,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"kms_key_id": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 					}},
 			},
 			"data_volumes": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"size": {
 							Type:     schema.TypeInt,
 							Required: true,
-							ForceNew: true,
 						},
 						"volumetype": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"hw_passthrough": {
 							Type:        schema.TypeBool,
 							Optional:    true,
-							ForceNew:    true,
 							Description: "schema: Internal",
 						},
 						"extend_param": {
 							Type:       schema.TypeString,
 							Optional:   true,
-							ForceNew:   true,
 							Deprecated: "use extend_params instead",
 						},
 						"extend_params": {
 							Type:     schema.TypeMap,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"kms_key_id": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 					}},
 			},
@@ -167,7 +156,6 @@ func ResourceCCENodePool() *schema.Resource {
 			"os": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 			"key_pair": {
@@ -356,7 +344,6 @@ func ResourceCCENodePool() *schema.Resource {
 			"runtime": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"docker", "containerd",
@@ -414,6 +401,51 @@ func ResourceCCENodePool() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"partition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"update_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "replace",
+				ValidateFunc: validation.StringInSlice([]string{
+					"replace", "rolling",
+				}, false),
+			},
+			"rolling_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_surge": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"max_unavailable": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"drain": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"drain_timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  300,
+						},
+					},
+				},
+			},
 			"current_node_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -501,6 +533,7 @@ func resourceCCENodePoolCreate(ctx context.Context, d *schema.ResourceData, meta
 				ExtendParam: resourceCCEExtendParam(d),
 				Taints:      resourceCCETaint(d),
 				UserTags:    resourceCCENodePoolTags(d),
+				Partition:   d.Get("partition").(string),
 			},
 			Autoscaling: nodepools.AutoscalingSpec{
 				Enable:                d.Get("scall_enable").(bool),
@@ -604,6 +637,7 @@ func resourceCCENodePoolRead(_ context.Context, d *schema.ResourceData, meta int
 		d.Set("priority", s.Spec.Autoscaling.Priority),
 		d.Set("type", s.Spec.Type),
 		d.Set("ecs_group_id", s.Spec.NodeManagement.ServerGroupReference),
+		d.Set("partition", s.Spec.NodeTemplate.Partition),
 		d.Set("storage", flattenStorage(s.Spec.NodeTemplate.Storage)),
 		d.Set("security_groups", s.Spec.CustomSecurityGroups),
 	)
@@ -751,21 +785,77 @@ func flattenStorage(storageRaw *nodes.StorageSpec) []map[string]interface{} {
 	}
 }
 
-func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	config := meta.(*config.Config)
-	nodePoolClient, err := config.CceV3Client(config.GetRegion(d))
-	if err != nil {
-		return fmtp.DiagErrorf("Error creating HuaweiCloud CCE client: %s", err)
+// nodePoolRollingKeys are the node template arguments which can only be applied to the existing nodes by replacing
+// them, they are updated in place only when the update strategy is rolling.
+var nodePoolRollingKeys = []string{"flavor_id", "os", "runtime", "root_volume", "data_volumes"}
+
+func resourceCCENodePoolCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChanges(nodePoolRollingKeys...) {
+		return nil
+	}
+	if d.Get("update_strategy").(string) == "rolling" {
+		// The nodes of the prePaid node pool can not be deleted, reject the rolling update before the node template is
+		// changed.
+		if d.Get("charging_mode").(string) == "prePaid" || d.Get("billing_mode").(int) != 0 {
+			return fmtp.Errorf("The rolling update of the prePaid CCE node pool (%s) is not supported", d.Id())
+		}
+		return nil
 	}
 
-	initialNodeCount := d.Get("initial_node_count").(int)
+	for _, key := range nodePoolRollingKeys {
+		if !d.HasChange(key) {
+			continue
+		}
+		if err := forceNewNodePoolChange(d, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forceNewNodePoolChange marks the changed argument as ForceNew. ForceNew on a whole list only forces the replacement
+// when the number of elements changes, so the changed nested fields of the volume lists are marked one by one.
+func forceNewNodePoolChange(d *schema.ResourceDiff, key string) error {
+	oRaw, nRaw := d.GetChange(key)
+	oList, isList := oRaw.([]interface{})
+	if !isList {
+		return d.ForceNew(key)
+	}
+	nList := nRaw.([]interface{})
+
+	if len(oList) != len(nList) {
+		return d.ForceNew(key)
+	}
+	for i := range nList {
+		fields := make(map[string]bool)
+		for _, elem := range []interface{}{oList[i], nList[i]} {
+			if m, ok := elem.(map[string]interface{}); ok {
+				for field := range m {
+					fields[field] = true
+				}
+			}
+		}
+		for field := range fields {
+			fieldKey := fmt.Sprintf("%s.%d.%s", key, i, field)
+			if !d.HasChange(fieldKey) {
+				continue
+			}
+			if err := d.ForceNew(fieldKey); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func buildNodePoolUpdateOpts(d *schema.ResourceData, nodeCount int) (*nodepools.UpdateOpts, error) {
 	var loginSpec nodes.LoginSpec
 	if common.HasFilledOpt(d, "key_pair") {
 		loginSpec = nodes.LoginSpec{SshKey: d.Get("key_pair").(string)}
 	} else if common.HasFilledOpt(d, "password") {
 		password, err := utils.TryPasswordEncrypt(d.Get("password").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return nil, err
 		}
 		loginSpec = nodes.LoginSpec{
 			UserPassword: nodes.UserPassword{
@@ -782,7 +872,7 @@ func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 			Name: d.Get("name").(string),
 		},
 		Spec: nodepools.UpdateSpec{
-			InitialNodeCount: &initialNodeCount,
+			InitialNodeCount: &nodeCount,
 			Autoscaling: nodepools.AutoscalingSpec{
 				Enable:                d.Get("scall_enable").(bool),
 				MinNodeCount:          d.Get("min_node_count").(int),
@@ -793,11 +883,13 @@ func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 			NodeTemplate: nodes.Spec{
 				Flavor:      d.Get("flavor_id").(string),
 				Az:          d.Get("availability_zone").(string),
+				Os:          d.Get("os").(string),
 				Login:       loginSpec,
 				RootVolume:  resourceCCERootVolume(d),
 				DataVolumes: resourceCCEDataVolume(d),
 				Count:       1,
 				UserTags:    resourceCCENodePoolTags(d),
+				Partition:   d.Get("partition").(string),
 				K8sTags:     resourceCCENodeK8sTags(d),
 				Taints:      resourceCCETaint(d),
 			},
@@ -805,28 +897,373 @@ func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta
 		},
 	}
 
+	if v, ok := d.GetOk("runtime"); ok {
+		updateOpts.Spec.NodeTemplate.RunTime = &nodes.RunTimeSpec{
+			Name: v.(string),
+		}
+	}
+
+	return &updateOpts, nil
+}
+
+func updateNodePool(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient, nodeCount int) error {
+	updateOpts, err := buildNodePoolUpdateOpts(d, nodeCount)
+	if err != nil {
+		return err
+	}
+
 	clusterid := d.Get("cluster_id").(string)
-	_, err = nodepools.Update(nodePoolClient, clusterid, d.Id(), updateOpts).Extract()
+	_, err = nodepools.Update(client, clusterid, d.Id(), updateOpts).Extract()
 	if err != nil {
-		return fmtp.DiagErrorf("Error updating HuaweiCloud Node Node Pool: %s", err)
+		return fmtp.Errorf("Error updating HuaweiCloud Node Node Pool: %s", err)
 	}
 
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{"Synchronizing"},
 		Target:       []string{""},
-		Refresh:      waitForCceNodePoolActive(nodePoolClient, clusterid, d.Id()),
-		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Refresh:      waitForCceNodePoolActive(client, clusterid, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
 		Delay:        60 * time.Second,
 		PollInterval: 10 * time.Second,
 	}
 	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmtp.DiagErrorf("Error updating HuaweiCloud CCE Node Pool: %s", err)
+		return fmtp.Errorf("Error updating HuaweiCloud CCE Node Pool: %s", err)
+	}
+	return nil
+}
+
+func resourceCCENodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*config.Config)
+	nodePoolClient, err := config.CceV3Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloud CCE client: %s", err)
+	}
+
+	if err = updateNodePool(ctx, d, nodePoolClient, d.Get("initial_node_count").(int)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(nodePoolRollingKeys...) {
+		if err = rollingUpdateNodePoolNodes(ctx, d, nodePoolClient); err != nil {
+			// Keep the previous node template in the state, so that the next apply detects the change again and
+			// resumes replacing the remaining outdated nodes.
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceCCENodePoolRead(ctx, d, meta)
 }
 
+// isVolumeOutdated checks whether the volume of the node is different from the volume of the node template, all the
+// volume arguments which are in nodePoolRollingKeys are compared. Only the extend params of the template are compared
+// since the node may have more of them.
+func isVolumeOutdated(volume, template nodes.VolumeSpec) bool {
+	if volume.Size != template.Size || volume.VolumeType != template.VolumeType ||
+		volume.HwPassthrough != template.HwPassthrough {
+		return true
+	}
+
+	var kmsKeyID, templateKmsKeyID string
+	if volume.Metadata != nil {
+		kmsKeyID = volume.Metadata.SystemCmkid
+	}
+	if template.Metadata != nil {
+		templateKmsKeyID = template.Metadata.SystemCmkid
+	}
+	if kmsKeyID != templateKmsKeyID {
+		return true
+	}
+
+	for k, v := range template.ExtendParam {
+		if fmt.Sprint(volume.ExtendParam[k]) != fmt.Sprint(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// isNodeOutdated checks whether the node is created from an old node template of the node pool.
+func isNodeOutdated(node nodes.Nodes, template nodes.Spec) bool {
+	spec := node.Spec
+	if spec.Flavor != template.Flavor || (template.Os != "" && spec.Os != template.Os) {
+		return true
+	}
+	if template.RunTime != nil && template.RunTime.Name != "" &&
+		(spec.RunTime == nil || spec.RunTime.Name != template.RunTime.Name) {
+		return true
+	}
+	if isVolumeOutdated(spec.RootVolume, template.RootVolume) {
+		return true
+	}
+	if len(spec.DataVolumes) != len(template.DataVolumes) {
+		return true
+	}
+	for i, volume := range template.DataVolumes {
+		if isVolumeOutdated(spec.DataVolumes[i], volume) {
+			return true
+		}
+	}
+	return false
+}
+
+// listNodePoolNodeIDs returns the IDs of all the nodes which belong to the node pool.
+func listNodePoolNodeIDs(client *golangsdk.ServiceClient, clusterID, nodePoolID string) (map[string]bool, error) {
+	allNodes, err := nodes.List(client, clusterID, nodes.ListOpts{})
+	if err != nil {
+		return nil, fmtp.Errorf("Error listing the nodes of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	result := make(map[string]bool)
+	for _, node := range allNodes {
+		if node.Metadata.Annotations["kubernetes.io/node-pool.id"] == nodePoolID {
+			result[node.Metadata.Id] = true
+		}
+	}
+	return result, nil
+}
+
+// waitForNodePoolNewNodesActive waits until the node pool has count new nodes, which are not in existingNodes, and
+// all the new nodes are active.
+func waitForNodePoolNewNodesActive(ctx context.Context, client *golangsdk.ServiceClient, clusterID,
+	nodePoolID string, existingNodes map[string]bool, count int, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Active"},
+		Refresh: func() (interface{}, string, error) {
+			allNodes, err := nodes.List(client, clusterID, nodes.ListOpts{})
+			if err != nil {
+				return nil, "failed", err
+			}
+
+			newNodes := make([]string, 0, count)
+			for _, node := range allNodes {
+				if node.Metadata.Annotations["kubernetes.io/node-pool.id"] != nodePoolID ||
+					existingNodes[node.Metadata.Id] {
+					continue
+				}
+				switch node.Status.Phase {
+				case "Active":
+					newNodes = append(newNodes, node.Metadata.Id)
+				case "Abnormal", "Error":
+					return allNodes, "failed", fmtp.Errorf("the new node (%s) is %s", node.Metadata.Id,
+						node.Status.Phase)
+				default:
+					return allNodes, "Pending", nil
+				}
+			}
+			if len(newNodes) < count {
+				return allNodes, "Pending", nil
+			}
+			return allNodes, "Active", nil
+		},
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmtp.Errorf("Error waiting for the new nodes of CCE Node Pool (%s) to become active: %s",
+			nodePoolID, err)
+	}
+	return nil
+}
+
+// listOutdatedNodePoolNodes returns the IDs of the nodes which belong to the node pool and are not created from the
+// current node template. The nodes which are being deleted are skipped.
+func listOutdatedNodePoolNodes(client *golangsdk.ServiceClient, clusterID, nodePoolID string,
+	template nodes.Spec) ([]string, error) {
+	allNodes, err := nodes.List(client, clusterID, nodes.ListOpts{})
+	if err != nil {
+		return nil, fmtp.Errorf("Error listing the nodes of CCE cluster (%s): %s", clusterID, err)
+	}
+
+	result := make([]string, 0)
+	for _, node := range allNodes {
+		if node.Metadata.Annotations["kubernetes.io/node-pool.id"] != nodePoolID || node.Status.Phase == "Deleting" {
+			continue
+		}
+		if isNodeOutdated(node, template) {
+			result = append(result, node.Metadata.Id)
+		}
+	}
+	return result, nil
+}
+
+// rollingUpdateNodePoolNodes replaces the nodes created from the old node template in batches. In each batch, at most
+// max_surge new nodes are created before the old nodes are deleted, and at most max_unavailable old nodes are deleted
+// before their replacements are created. The outdated nodes are listed again before each batch, so an interrupted
+// rolling update can be resumed, and the node count is restored if any batch fails.
+func rollingUpdateNodePoolNodes(ctx context.Context, d *schema.ResourceData,
+	client *golangsdk.ServiceClient) (err error) {
+	var (
+		clusterID      = d.Get("cluster_id").(string)
+		maxSurge       = 1
+		maxUnavailable = 0
+		drain          = true
+		drainTimeout   = 300
+	)
+	if rollingRaw := d.Get("rolling_update").([]interface{}); len(rollingRaw) > 0 && rollingRaw[0] != nil {
+		rolling := rollingRaw[0].(map[string]interface{})
+		maxSurge = rolling["max_surge"].(int)
+		maxUnavailable = rolling["max_unavailable"].(int)
+		drain = rolling["drain"].(bool)
+		drainTimeout = rolling["drain_timeout"].(int)
+	}
+	if maxSurge+maxUnavailable == 0 {
+		return fmtp.Errorf("max_surge and max_unavailable of the rolling update can not be both 0")
+	}
+
+	pool, err := nodepools.Get(client, clusterID, d.Id()).Extract()
+	if err != nil {
+		return fmtp.Errorf("Error retrieving HuaweiCloud CCE Node Pool (%s): %s", d.Id(), err)
+	}
+	nodeCount := d.Get("initial_node_count").(int)
+	template := pool.Spec.NodeTemplate
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		// Restore the node count raised for the surge nodes or decreased by the deleted nodes.
+		if restoreErr := updateNodePool(ctx, d, client, nodeCount); restoreErr != nil {
+			logp.Printf("[WARN] failed to restore the node count of CCE Node Pool (%s) to %d: %s",
+				d.Id(), nodeCount, restoreErr)
+		}
+	}()
+
+	for {
+		var oldNodes []string
+		oldNodes, err = listOutdatedNodePoolNodes(client, clusterID, d.Id(), template)
+		if err != nil {
+			return err
+		}
+		if len(oldNodes) == 0 {
+			return nil
+		}
+
+		surge := maxSurge
+		if surge > len(oldNodes) {
+			surge = len(oldNodes)
+		}
+		batchSize := surge + maxUnavailable
+		if batchSize > len(oldNodes) {
+			batchSize = len(oldNodes)
+		}
+		batch := oldNodes[:batchSize]
+
+		if surge > 0 {
+			var existingNodes map[string]bool
+			existingNodes, err = listNodePoolNodeIDs(client, clusterID, d.Id())
+			if err != nil {
+				return err
+			}
+			logp.Printf("[DEBUG] Scaling up the CCE Node Pool (%s) by %d node(s) before replacing %v", d.Id(), surge, batch)
+			if err = updateNodePool(ctx, d, client, nodeCount+surge); err != nil {
+				return err
+			}
+			// The old nodes are drained only after their replacements are ready to run the evicted pods.
+			err = waitForNodePoolNewNodesActive(ctx, client, clusterID, d.Id(), existingNodes, surge,
+				d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+		}
+
+		if drain {
+			if err = drainCCENodes(ctx, client, clusterID, batch, drainTimeout, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+		for _, nodeID := range batch {
+			if err = deleteNodePoolNode(ctx, client, clusterID, nodeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		// Deleting a node also decreases the node count of the node pool, so restore it to create the replacements
+		// of the unavailable nodes and make sure the node count is expected.
+		if err = updateNodePool(ctx, d, client, nodeCount); err != nil {
+			return err
+		}
+	}
+}
+
+func drainCCENodes(ctx context.Context, client *golangsdk.ServiceClient, clusterID string, nodeIDs []string,
+	drainTimeout int, timeout time.Duration) error {
+	nodeItems := make([]map[string]interface{}, len(nodeIDs))
+	for i, id := range nodeIDs {
+		nodeItems[i] = map[string]interface{}{"uid": id}
+	}
+
+	drainPath := client.ServiceURL("clusters", clusterID, "nodes", "operation", "drain")
+	drainOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"apiVersion": "v3",
+			"kind":       "DrainNodesTask",
+			"spec": map[string]interface{}{
+				"nodes": nodeItems,
+				"drainOptions": map[string]interface{}{
+					"ignoreDaemonSets": true,
+					"deleteLocalData":  true,
+					"timeoutSeconds":   drainTimeout,
+				},
+			},
+		},
+	}
+	drainResp, err := client.Request("PUT", drainPath, &drainOpt)
+	if err != nil {
+		return fmtp.Errorf("Error draining CCE nodes %v: %s", nodeIDs, err)
+	}
+
+	drainRespBody, err := utils.FlattenResponse(drainResp)
+	if err != nil {
+		return err
+	}
+	jobID := utils.PathSearch("status.jobID", drainRespBody, "").(string)
+	if jobID == "" {
+		return nil
+	}
+
+	stateJob := &resource.StateChangeConf{
+		Pending:      []string{"Initializing", "Running"},
+		Target:       []string{"Success"},
+		Refresh:      waitForJobStatus(client, jobID),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err = stateJob.WaitForStateContext(ctx)
+	if err != nil {
+		return fmtp.Errorf("Error waiting for CCE nodes %v to be drained: %s", nodeIDs, err)
+	}
+	return nil
+}
+
+func deleteNodePoolNode(ctx context.Context, client *golangsdk.ServiceClient, clusterID, nodeID string,
+	timeout time.Duration) error {
+	err := nodes.Delete(client, clusterID, nodeID).ExtractErr()
+	if err != nil {
+		return fmtp.Errorf("Error deleting HuaweiCloud CCE Node (%s): %s", nodeID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Deleting"},
+		Target:       []string{"Deleted"},
+		Refresh:      waitForCceNodeDelete(client, clusterID, nodeID),
+		Timeout:      timeout,
+		Delay:        60 * time.Second,
+		PollInterval: 20 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmtp.Errorf("Error deleting HuaweiCloud CCE Node (%s): %s", nodeID, err)
+	}
+	return nil
+}
+
 func resourceCCENodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	nodePoolClient, err := config.CceV3Client(config.GetRegion(d))
@@ -362,6 +362,12 @@ func ResourceCCENodeV3() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"partition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"ecs_performance_type": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -790,6 +796,7 @@ func resourceCCENodeV3Create(ctx context.Context, d *schema.ResourceData, meta i
 				},
 			},
 			EcsGroupID:  d.Get("ecs_group_id").(string),
+			Partition:   d.Get("partition").(string),
 			ExtendParam: resourceCCEExtendParam(d),
 			Taints:      resourceCCETaint(d),
 			K8sTags:     resourceCCENodeK8sTags(d),
@@ -895,6 +902,7 @@ func resourceCCENodeV3Read(_ context.Context, d *schema.ResourceData, meta inter
 		d.Set("key_pair", s.Spec.Login.SshKey),
 		d.Set("subnet_id", s.Spec.NodeNicSpec.PrimaryNic.SubnetId),
 		d.Set("ecs_group_id", s.Spec.EcsGroupID),
+		d.Set("partition", s.Spec.Partition),
 	)
 
 	if s.Spec.BillingMode != 0 {
@@ -0,0 +1,244 @@
+package cce
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePartition() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePartitionCreate,
+		ReadContext:   resourcePartitionRead,
+		UpdateContext: resourcePartitionUpdate,
+		DeleteContext: resourcePartitionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePartitionImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the CCE cluster.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the partition.`,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Default", "IES",
+				}, false),
+				Description: `Specifies the category of the partition.`,
+			},
+			"public_border_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the public border group of the partition, such as the edge zone name.`,
+			},
+			"partition_subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the subnet used by the nodes in the partition.`,
+			},
+			"container_subnet_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the IPv4 subnet IDs used by the containers in the partition.`,
+			},
+		},
+	}
+}
+
+func buildPartitionPath(client *golangsdk.ServiceClient, clusterId, name string) string {
+	path := client.Endpoint + "api/v3/projects/{project_id}/clusters/{cluster_id}/partitions"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{cluster_id}", clusterId)
+	if name != "" {
+		path = path + "/" + name
+	}
+	return path
+}
+
+func buildPartitionContainerNetworkParams(d *schema.ResourceData) []map[string]interface{} {
+	subnetIds := utils.ExpandToStringList(d.Get("container_subnet_ids").([]interface{}))
+	if len(subnetIds) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(subnetIds))
+	for i, id := range subnetIds {
+		result[i] = map[string]interface{}{
+			"subnetID": id,
+		}
+	}
+	return result
+}
+
+func buildPartitionCreateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"kind":       "Partition",
+		"apiVersion": "v3",
+		"metadata": map[string]interface{}{
+			"name": d.Get("name"),
+		},
+		"spec": map[string]interface{}{
+			"category":          utils.ValueIngoreEmpty(d.Get("category")),
+			"publicBorderGroup": utils.ValueIngoreEmpty(d.Get("public_border_group")),
+			"hostNetwork": map[string]interface{}{
+				"subnetID": d.Get("partition_subnet_id"),
+			},
+			"containerNetwork": buildPartitionContainerNetworkParams(d),
+		},
+	}
+}
+
+func resourcePartitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cce", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201,
+		},
+		JSONBody: utils.RemoveNil(buildPartitionCreateBodyParams(d)),
+	}
+	_, err = client.Request("POST", buildPartitionPath(client, clusterId, ""), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating CCE partition: %s", err)
+	}
+
+	d.SetId(d.Get("name").(string))
+	return resourcePartitionRead(ctx, d, meta)
+}
+
+func resourcePartitionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("cce", region)
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", buildPartitionPath(client, d.Get("cluster_id").(string), d.Id()), &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CCE partition")
+	}
+
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("metadata.name", getRespBody, nil)),
+		d.Set("category", utils.PathSearch("spec.category", getRespBody, nil)),
+		d.Set("public_border_group", utils.PathSearch("spec.publicBorderGroup", getRespBody, nil)),
+		d.Set("partition_subnet_id", utils.PathSearch("spec.hostNetwork.subnetID", getRespBody, nil)),
+		d.Set("container_subnet_ids", utils.PathSearch("spec.containerNetwork[*].subnetID", getRespBody, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourcePartitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cce", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	if d.HasChange("container_subnet_ids") {
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+			JSONBody: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name": d.Id(),
+				},
+				"spec": map[string]interface{}{
+					"containerNetwork": buildPartitionContainerNetworkParams(d),
+				},
+			},
+		}
+		_, err = client.Request("PUT", buildPartitionPath(client, d.Get("cluster_id").(string), d.Id()), &updateOpt)
+		if err != nil {
+			return diag.Errorf("error updating CCE partition (%s): %s", d.Id(), err)
+		}
+	}
+	return resourcePartitionRead(ctx, d, meta)
+}
+
+func resourcePartitionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("cce", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CCE Client: %s", err)
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	_, err = client.Request("DELETE", buildPartitionPath(client, d.Get("cluster_id").(string), d.Id()), &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CCE partition")
+	}
+	return nil
+}
+
+func resourcePartitionImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for CCE partition, must be <cluster_id>/<name>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("cluster_id", parts[0])
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/chnsz/golangsdk"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -76,7 +77,11 @@ func ResourceCssCluster() *schema.Resource {
 			"engine_version": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+			},
+
+			"upgrade_agency": {
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 
 			"security_mode": {
@@ -456,7 +461,6 @@ func cssNodeSchema(min, max int, canExtendsVolume bool) *schema.Resource {
 			"flavor": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"instance_number": {
@@ -965,9 +969,27 @@ func resourceCssClusterUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error creating CSS V1 client: %s", err)
 	}
 
-	// extend cluster
+	// upgrade engine version
+	if d.HasChange("engine_version") {
+		err = upgradeCluster(ctx, d, config, cssV1Client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// shrink cluster, change flavor and extend cluster
 	if d.HasChanges("ess_node_config", "master_node_config", "client_node_config",
 		"cold_node_config", "expect_node_num") {
+		err = shrinkCluster(ctx, d, cssV1Client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = updateClusterFlavor(ctx, d, cssV1Client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		err = extendCluster(ctx, d, cssV1Client)
 		if err != nil {
 			return diag.FromErr(err)
@@ -1032,6 +1054,9 @@ func extendCluster(ctx context.Context, d *schema.ResourceData, cssV1Client *v1.
 	if err != nil {
 		return fmt.Errorf("error building the request body of api(extend_cluster), err=%s", err)
 	}
+	if len(opts.Body.Grow) == 0 {
+		return nil
+	}
 	_, err = cssV1Client.UpdateExtendInstanceStorage(opts)
 	if err != nil {
 		return fmt.Errorf("extend CSS cluster instance storage failed, cluster_id=%s, error=%s", d.Id(), err)
@@ -1322,72 +1347,50 @@ func updatePublicAccess(ctx context.Context, d *schema.ResourceData, cssV1Client
 	return nil
 }
 
+// nodeConfigKeys maps the node configurations to the node types, the volume of master and client nodes can not be
+// extended.
+var nodeConfigKeys = []struct {
+	key              string
+	nodeType         string
+	canExtendsVolume bool
+}{
+	{key: "ess_node_config", nodeType: InstanceTypeEss, canExtendsVolume: true},
+	{key: "cold_node_config", nodeType: InstanceTypeEssCold, canExtendsVolume: true},
+	{key: "master_node_config", nodeType: InstanceTypeEssMaster},
+	{key: "client_node_config", nodeType: InstanceTypeEssClient},
+}
+
 func buildCssClusterV1ExtendClusterParameters(d *schema.ResourceData) (*model.UpdateExtendInstanceStorageRequest, error) {
 	var grow = make([]model.RoleExtendGrowReq, 0, 4)
 
-	if d.HasChange("ess_node_config") {
-		oldv, newv := d.GetChange("ess_node_config.0.instance_number")
-		nodesize := newv.(int) - oldv.(int)
-		if nodesize < 0 {
-			return nil, fmt.Errorf("instance_number only supports to be extended")
+	for _, v := range nodeConfigKeys {
+		if !d.HasChange(v.key) {
+			continue
 		}
 
-		oldDisksize, newDisksize := d.GetChange("ess_node_config.0.volume.0.size")
-		disksize := newDisksize.(int) - oldDisksize.(int)
-		if disksize < 0 {
-			return nil, fmt.Errorf("volume size only supports to be extended")
-		}
-
-		grow = append(grow, model.RoleExtendGrowReq{
-			Type:     InstanceTypeEss,
-			Nodesize: int32(nodesize),
-			Disksize: int32(disksize),
-		})
-	}
-
-	if d.HasChange("cold_node_config") {
-		oldv, newv := d.GetChange("cold_node_config.0.instance_number")
+		oldv, newv := d.GetChange(v.key + ".0.instance_number")
+		// the decreased nodes are deleted by shrinkCluster
 		nodesize := newv.(int) - oldv.(int)
 		if nodesize < 0 {
-			return nil, fmt.Errorf("instance_number only supports to be extended")
+			nodesize = 0
 		}
 
-		oldDisksize, newDisksize := d.GetChange("cold_node_config.0.volume.0.size")
-		disksize := newDisksize.(int) - oldDisksize.(int)
-		if disksize < 0 {
-			return nil, fmt.Errorf("volume size only supports to be extended")
-		}
-
-		grow = append(grow, model.RoleExtendGrowReq{
-			Type:     InstanceTypeEssCold,
-			Nodesize: int32(nodesize),
-			Disksize: int32(disksize),
-		})
-	}
-
-	if d.HasChange("master_node_config") {
-		oldv, newv := d.GetChange("master_node_config.0.instance_number")
-		nodesize := newv.(int) - oldv.(int)
-		if nodesize < 0 {
-			return nil, fmt.Errorf("instance_number only supports to be extended")
+		var disksize int
+		if v.canExtendsVolume {
+			oldDisksize, newDisksize := d.GetChange(v.key + ".0.volume.0.size")
+			disksize = newDisksize.(int) - oldDisksize.(int)
+			if disksize < 0 {
+				return nil, fmt.Errorf("volume size only supports to be extended")
+			}
 		}
 
-		grow = append(grow, model.RoleExtendGrowReq{
-			Type:     InstanceTypeEssMaster,
-			Nodesize: int32(nodesize),
-		})
-	}
-
-	if d.HasChange("client_node_config") {
-		oldv, newv := d.GetChange("client_node_config.0.instance_number")
-		nodesize := newv.(int) - oldv.(int)
-		if nodesize < 0 {
-			return nil, fmt.Errorf("instance_number only supports to be extended")
+		if nodesize == 0 && disksize == 0 {
+			continue
 		}
-
 		grow = append(grow, model.RoleExtendGrowReq{
-			Type:     InstanceTypeEssClient,
+			Type:     v.nodeType,
 			Nodesize: int32(nodesize),
+			Disksize: int32(disksize),
 		})
 	}
 
@@ -1419,6 +1422,136 @@ func buildCssClusterV1ExtendClusterParameters(d *schema.ResourceData) (*model.Up
 	}, nil
 }
 
+// shrinkCluster deletes the nodes of the types whose instance_number is decreased.
+func shrinkCluster(ctx context.Context, d *schema.ResourceData, cssV1Client *v1.CssClient) error {
+	var shrink = make([]model.ShrinkNodeReq, 0, 4)
+	for _, v := range nodeConfigKeys {
+		if !d.HasChange(v.key) {
+			continue
+		}
+
+		oldv, newv := d.GetChange(v.key + ".0.instance_number")
+		if reduced := oldv.(int) - newv.(int); reduced > 0 {
+			shrink = append(shrink, model.ShrinkNodeReq{
+				Type:           v.nodeType,
+				ReducedNodeNum: int32(reduced),
+			})
+		}
+	}
+	if len(shrink) == 0 {
+		return nil
+	}
+
+	_, err := cssV1Client.UpdateShrinkCluster(&model.UpdateShrinkClusterRequest{
+		ClusterId: d.Id(),
+		Body: &model.ShrinkClusterReq{
+			Shrink: shrink,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("shrink CSS cluster failed, cluster_id=%s, error=%s", d.Id(), err)
+	}
+
+	return checkClusterOperationCompleted(ctx, cssV1Client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
+
+// updateClusterFlavor changes the flavor of the nodes type by type, the nodes are changed one by one.
+func updateClusterFlavor(ctx context.Context, d *schema.ResourceData, cssV1Client *v1.CssClient) error {
+	for _, v := range nodeConfigKeys {
+		if !d.HasChange(v.key + ".0.flavor") {
+			continue
+		}
+
+		opts := &model.UpdateFlavorByTypeRequest{
+			ClusterId: d.Id(),
+			Types:     v.nodeType,
+			Body: &model.UpdateFlavorByTypeReq{
+				NewFlavorId: d.Get(v.key + ".0.flavor").(string),
+			},
+		}
+		if d.Get("charging_mode").(string) == "prePaid" {
+			opts.Body.IsAutoPay = utils.Int32(1)
+		}
+		_, err := cssV1Client.UpdateFlavorByType(opts)
+		if err != nil {
+			return fmt.Errorf("error updating the flavor of %s nodes of CSS cluster= %s, err: %s", v.nodeType,
+				d.Id(), err)
+		}
+
+		err = checkClusterOperationCompleted(ctx, cssV1Client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upgradeCluster upgrades the engine of all nodes to the image of the new version, the nodes are upgraded in rolling
+// mode so that the cluster keeps available.
+func upgradeCluster(ctx context.Context, d *schema.ResourceData, cfg *config.Config, cssV1Client *v1.CssClient) error {
+	var (
+		listImagesHttpUrl   = "v2.0/{project_id}/clusters/{cluster_id}/target/{upgrade_type}/images"
+		upgradeHttpUrl      = "v2.0/{project_id}/clusters/{cluster_id}/inst-type/all/image/upgrade"
+		upgradeProduct      = "css"
+		upgradeType         = "cross"
+		targetEngineVersion = d.Get("engine_version").(string)
+	)
+	upgradeClient, err := cfg.NewServiceClient(upgradeProduct, cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS client: %s", err)
+	}
+
+	listImagesPath := upgradeClient.Endpoint + listImagesHttpUrl
+	listImagesPath = strings.ReplaceAll(listImagesPath, "{project_id}", upgradeClient.ProjectID)
+	listImagesPath = strings.ReplaceAll(listImagesPath, "{cluster_id}", d.Id())
+	listImagesPath = strings.ReplaceAll(listImagesPath, "{upgrade_type}", upgradeType)
+
+	listImagesOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	listImagesResp, err := upgradeClient.Request("GET", listImagesPath, &listImagesOpt)
+	if err != nil {
+		return fmt.Errorf("error retrieving the target images of CSS cluster= %s, err: %s", d.Id(), err)
+	}
+	listImagesRespBody, err := utils.FlattenResponse(listImagesResp)
+	if err != nil {
+		return err
+	}
+
+	expression := fmt.Sprintf("imageInfoList[?datastoreVersion=='%s']|[0].id", targetEngineVersion)
+	imageId := utils.PathSearch(expression, listImagesRespBody, "").(string)
+	if imageId == "" {
+		return fmt.Errorf("unable to find the target image of version %s for CSS cluster= %s", targetEngineVersion,
+			d.Id())
+	}
+
+	upgradePath := upgradeClient.Endpoint + upgradeHttpUrl
+	upgradePath = strings.ReplaceAll(upgradePath, "{project_id}", upgradeClient.ProjectID)
+	upgradePath = strings.ReplaceAll(upgradePath, "{cluster_id}", d.Id())
+
+	upgradeOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"target_image_id":      imageId,
+			"upgrade_type":         upgradeType,
+			"indices_backup_check": true,
+			"agency":               utils.ValueIngoreEmpty(d.Get("upgrade_agency")),
+		}),
+	}
+	_, err = upgradeClient.Request("POST", upgradePath, &upgradeOpt)
+	if err != nil {
+		return fmt.Errorf("error upgrading CSS cluster= %s to version %s, err: %s", d.Id(), targetEngineVersion, err)
+	}
+
+	return checkClusterOperationCompleted(ctx, cssV1Client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
+
 func resourceCssClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	region := config.GetRegion(d)
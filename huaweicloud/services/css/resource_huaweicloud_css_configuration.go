@@ -0,0 +1,324 @@
+package css
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCssConfiguration is the impl of huaweicloud_css_configuration resource, which manages the custom parameters
+// of the elasticsearch.yml of the cluster. The ID is the cluster ID.
+func ResourceCssConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCssConfigurationCreate,
+		ReadContext:   resourceCssConfigurationRead,
+		UpdateContext: resourceCssConfigurationUpdate,
+		DeleteContext: resourceCssConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCssConfigurationImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCssConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	err = modifyCssConfiguration(ctx, cssV1Client, clusterId, d.Get("parameters").(map[string]interface{}),
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(clusterId)
+
+	return resourceCssConfigurationRead(ctx, d, meta)
+}
+
+func modifyCssConfiguration(ctx context.Context, cssV1Client *v1.CssClient, clusterId string,
+	parameters map[string]interface{}, timeout time.Duration) error {
+	jobIds, err := listCssConfigurationJobIds(cssV1Client, clusterId)
+	if err != nil {
+		return err
+	}
+
+	var ymlParameters interface{} = parameters
+	_, err = cssV1Client.UpdateYmls(&model.UpdateYmlsRequest{
+		ClusterId: clusterId,
+		Body: &model.UpdateYmlsReq{
+			Edit: &model.UpdateYmlsReqEdit{
+				Modify: &model.UpdateYmlsReqEditModify{
+					ElasticsearchYml: &ymlParameters,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error modifying the parameters of CSS cluster= %s, err: %s", clusterId, err)
+	}
+
+	return checkCssConfigurationJobCompleted(ctx, cssV1Client, clusterId, jobIds, timeout)
+}
+
+// resetCssConfiguration restores the default values of the parameters, the SDK only supports the modify operation so
+// the reset operation is sent by the raw request.
+func resetCssConfiguration(ctx context.Context, cfg *config.Config, d *schema.ResourceData, cssV1Client *v1.CssClient,
+	parameters map[string]interface{}, timeout time.Duration) error {
+	var (
+		resetConfigurationHttpUrl = "v1.0/{project_id}/clusters/{cluster_id}/ymls/update"
+		resetConfigurationProduct = "css"
+	)
+	resetConfigurationClient, err := cfg.NewServiceClient(resetConfigurationProduct, cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating CSS client: %s", err)
+	}
+
+	resetConfigurationPath := resetConfigurationClient.Endpoint + resetConfigurationHttpUrl
+	resetConfigurationPath = strings.ReplaceAll(resetConfigurationPath, "{project_id}",
+		resetConfigurationClient.ProjectID)
+	resetConfigurationPath = strings.ReplaceAll(resetConfigurationPath, "{cluster_id}", d.Id())
+
+	jobIds, err := listCssConfigurationJobIds(cssV1Client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	resetConfigurationOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			"edit": map[string]interface{}{
+				"reset": map[string]interface{}{
+					"elasticsearch.yml": parameters,
+				},
+			},
+		},
+	}
+	_, err = resetConfigurationClient.Request("POST", resetConfigurationPath, &resetConfigurationOpt)
+	if err != nil {
+		return fmt.Errorf("error resetting the parameters of CSS cluster= %s, err: %s", d.Id(), err)
+	}
+
+	return checkCssConfigurationJobCompleted(ctx, cssV1Client, d.Id(), jobIds, timeout)
+}
+
+// listCssConfigurationJobIds returns the IDs of the existing parameter modification jobs of the cluster, which are
+// used to find the job created by the next modification.
+func listCssConfigurationJobIds(cssV1Client *v1.CssClient, clusterId string) (map[string]bool, error) {
+	resp, err := cssV1Client.ListYmlsJob(&model.ListYmlsJobRequest{ClusterId: clusterId})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the parameter modification jobs of CSS cluster (%s): %s",
+			clusterId, err)
+	}
+
+	jobIds := make(map[string]bool)
+	if resp.ConfigList != nil {
+		for _, job := range *resp.ConfigList {
+			jobIds[utils.StringValue(job.Id)] = true
+		}
+	}
+	return jobIds, nil
+}
+
+// checkCssConfigurationJobCompleted waits for the parameter modification job which is not in the existing jobIds,
+// that is the job created by the latest modification.
+func checkCssConfigurationJobCompleted(ctx context.Context, cssV1Client *v1.CssClient, clusterId string,
+	jobIds map[string]bool, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Done"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := cssV1Client.ListYmlsJob(&model.ListYmlsJobRequest{ClusterId: clusterId})
+			if err != nil {
+				return nil, "failed", err
+			}
+
+			var job *model.ConfigListRsp
+			if resp.ConfigList != nil {
+				for i, v := range *resp.ConfigList {
+					if !jobIds[utils.StringValue(v.Id)] {
+						job = &(*resp.ConfigList)[i]
+						break
+					}
+				}
+			}
+			if job == nil {
+				return resp, "Pending", nil
+			}
+
+			switch strings.ToLower(utils.StringValue(job.Status)) {
+			case "success":
+				return resp, "Done", nil
+			case "failed":
+				return resp, "failed", fmt.Errorf("the parameter modification job failed: %s",
+					utils.StringValue(job.FailedMsg))
+			}
+			return resp, "Pending", nil
+		},
+		Timeout:      timeout,
+		PollInterval: 10 * time.Second,
+		Delay:        5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the parameters of CSS (%s) to be modified: %s", clusterId, err)
+	}
+	return nil
+}
+
+func resourceCssConfigurationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	cssV1Client, err := cfg.HcCssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	resp, err := cssV1Client.ListYmls(&model.ListYmlsRequest{ClusterId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the parameters of CSS cluster")
+	}
+
+	var configurations interface{}
+	if resp.Configurations != nil {
+		configurations = *resp.Configurations
+	}
+
+	// only the parameters managed by this resource are refreshed, the others are kept as default values
+	parameters := make(map[string]interface{})
+	for k := range d.Get("parameters").(map[string]interface{}) {
+		value := utils.PathSearch(fmt.Sprintf("\"%s\".value", k), configurations, nil)
+		if value != nil {
+			parameters[k] = fmt.Sprint(value)
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", d.Id()),
+		d.Set("parameters", parameters),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// resourceCssConfigurationImportState loads the parameters whose values are different from the default values, since
+// the parameters managed by the resource are unknown when importing.
+func resourceCssConfigurationImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	resp, err := cssV1Client.ListYmls(&model.ListYmlsRequest{ClusterId: d.Id()})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving the parameters of CSS cluster (%s): %s", d.Id(), err)
+	}
+
+	parameters := make(map[string]interface{})
+	if resp.Configurations != nil {
+		if configurations, ok := (*resp.Configurations).(map[string]interface{}); ok {
+			for k, v := range configurations {
+				value := utils.PathSearch("value", v, nil)
+				if value != nil && fmt.Sprint(value) != fmt.Sprint(utils.PathSearch("defaultValue", v, nil)) {
+					parameters[k] = fmt.Sprint(value)
+				}
+			}
+		}
+	}
+
+	if err = d.Set("parameters", parameters); err != nil {
+		return nil, fmt.Errorf("error setting the parameters of CSS configuration: %s", err)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCssConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	oRaw, nRaw := d.GetChange("parameters")
+	newParameters := nRaw.(map[string]interface{})
+	removedParameters := make(map[string]interface{})
+	for k, v := range oRaw.(map[string]interface{}) {
+		if _, ok := newParameters[k]; !ok {
+			removedParameters[k] = v
+		}
+	}
+
+	if len(removedParameters) > 0 {
+		err = resetCssConfiguration(ctx, cfg, d, cssV1Client, removedParameters, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = modifyCssConfiguration(ctx, cssV1Client, d.Id(), newParameters, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCssConfigurationRead(ctx, d, meta)
+}
+
+func resourceCssConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	err = resetCssConfiguration(ctx, cfg, d, cssV1Client, d.Get("parameters").(map[string]interface{}),
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
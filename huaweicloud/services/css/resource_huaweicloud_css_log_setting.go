@@ -0,0 +1,204 @@
+package css
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceLogSetting is the impl of huaweicloud_css_log_setting resource, which backs up the logs of the cluster to
+// the OBS bucket. The ID is the cluster ID.
+func ResourceLogSetting() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLogSettingCreate,
+		ReadContext:   resourceLogSettingRead,
+		UpdateContext: resourceLogSettingUpdate,
+		DeleteContext: resourceLogSettingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agency": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"base_path": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"period": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auto_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLogSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	_, err = cssV1Client.StartLogs(&model.StartLogsRequest{
+		ClusterId: clusterId,
+		Body: &model.StartLogsReq{
+			Agency:      d.Get("agency").(string),
+			LogBucket:   d.Get("bucket").(string),
+			LogBasePath: d.Get("base_path").(string),
+		},
+	})
+	if err != nil {
+		return diag.Errorf("error enabling the log backup of CSS cluster= %s, err: %s", clusterId, err)
+	}
+	d.SetId(clusterId)
+
+	if period, ok := d.GetOk("period"); ok {
+		err = startLogAutoBackupPolicy(cssV1Client, clusterId, period.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceLogSettingRead(ctx, d, meta)
+}
+
+func startLogAutoBackupPolicy(cssV1Client *v1.CssClient, clusterId, period string) error {
+	_, err := cssV1Client.StartLogAutoBackupPolicy(&model.StartLogAutoBackupPolicyRequest{
+		ClusterId: clusterId,
+		Body: &model.StartLogAutoBackupPolicyReq{
+			Period: period,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error enabling the automatic log backup of CSS cluster= %s, err: %s", clusterId, err)
+	}
+	return nil
+}
+
+func resourceLogSettingRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	cssV1Client, err := cfg.HcCssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	resp, err := cssV1Client.ShowGetLogSetting(&model.ShowGetLogSettingRequest{ClusterId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving the log setting of CSS cluster")
+	}
+
+	logConfiguration := resp.LogConfiguration
+	if logConfiguration == nil || logConfiguration.LogSwitch == nil || !*logConfiguration.LogSwitch {
+		d.SetId("")
+		return nil
+	}
+
+	var updatedAt string
+	if logConfiguration.UpdateAt != nil {
+		updatedAt = utils.FormatTimeStampRFC3339(*logConfiguration.UpdateAt/1000, false)
+	}
+
+	autoEnabled := logConfiguration.AutoEnable != nil && *logConfiguration.AutoEnable
+	var period string
+	if autoEnabled {
+		period = utils.StringValue(logConfiguration.Period)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", d.Id()),
+		d.Set("agency", logConfiguration.Agency),
+		d.Set("bucket", logConfiguration.ObsBucket),
+		d.Set("base_path", logConfiguration.BasePath),
+		d.Set("period", period),
+		d.Set("auto_enabled", autoEnabled),
+		d.Set("updated_at", updatedAt),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceLogSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	if d.HasChanges("agency", "bucket", "base_path") {
+		_, err = cssV1Client.UpdateLogSetting(&model.UpdateLogSettingRequest{
+			ClusterId: d.Id(),
+			Body: &model.UpdateLogSettingReq{
+				Agency:      d.Get("agency").(string),
+				LogBucket:   d.Get("bucket").(string),
+				LogBasePath: d.Get("base_path").(string),
+			},
+		})
+		if err != nil {
+			return diag.Errorf("error updating the log setting of CSS cluster= %s, err: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("period") {
+		if period, ok := d.GetOk("period"); ok {
+			err = startLogAutoBackupPolicy(cssV1Client, d.Id(), period.(string))
+		} else {
+			_, err = cssV1Client.StopLogAutoBackupPolicy(&model.StopLogAutoBackupPolicyRequest{ClusterId: d.Id()})
+		}
+		if err != nil {
+			return diag.Errorf("error updating the automatic log backup of CSS cluster= %s, err: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLogSettingRead(ctx, d, meta)
+}
+
+func resourceLogSettingDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	_, err = cssV1Client.StopLogs(&model.StopLogsRequest{ClusterId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling the log backup of CSS cluster")
+	}
+	return nil
+}
@@ -0,0 +1,338 @@
+package dcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDcsAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcsAccountCreate,
+		ReadContext:   resourceDcsAccountRead,
+		UpdateContext: resourceDcsAccountUpdate,
+		DeleteContext: resourceDcsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDcsAccountImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DCS instance.`,
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the account.`,
+			},
+			"account_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: `Specifies the password of the account.`,
+			},
+			"account_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"read", "write"}, false),
+				Description:  `Specifies the permission of the account.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the description of the account.`,
+			},
+			"account_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the type of the account.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the account.`,
+			},
+		},
+	}
+}
+
+func buildDcsAccountPath(client *golangsdk.ServiceClient, httpUrl, instanceId, accountId string) string {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceId)
+	return strings.ReplaceAll(path, "{account_id}", accountId)
+}
+
+func resourceDcsAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createAccount: create DCS account
+	var (
+		createAccountHttpUrl = "v2/{project_id}/instances/{instance_id}/accounts"
+		createAccountProduct = "dcs"
+	)
+	createAccountClient, err := cfg.NewServiceClient(createAccountProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DCS Client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createAccountPath := buildDcsAccountPath(createAccountClient, createAccountHttpUrl, instanceId, "")
+	createAccountOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	createAccountOpt.JSONBody = utils.RemoveNil(map[string]interface{}{
+		"account_name":     d.Get("account_name"),
+		"account_password": d.Get("account_password"),
+		"account_role":     d.Get("account_role"),
+		"description":      utils.ValueIngoreEmpty(d.Get("description")),
+	})
+	createAccountResp, err := createAccountClient.Request("POST", createAccountPath, &createAccountOpt)
+	if err != nil {
+		return diag.Errorf("error creating DCS account: %s", err)
+	}
+
+	createAccountRespBody, err := utils.FlattenResponse(createAccountResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := utils.PathSearch("account_id", createAccountRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating DCS account: account_id is not found in API response")
+	}
+	d.SetId(id)
+
+	err = waitForDcsAccountAvailable(ctx, createAccountClient, instanceId, id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDcsAccountRead(ctx, d, meta)
+}
+
+func getDcsAccount(client *golangsdk.ServiceClient, instanceId, accountId string) (interface{}, error) {
+	getAccountHttpUrl := "v2/{project_id}/instances/{instance_id}/accounts"
+	getAccountPath := buildDcsAccountPath(client, getAccountHttpUrl, instanceId, "")
+	getAccountOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getAccountResp, err := client.Request("GET", getAccountPath, &getAccountOpt)
+	if err != nil {
+		return nil, err
+	}
+	getAccountRespBody, err := utils.FlattenResponse(getAccountResp)
+	if err != nil {
+		return nil, err
+	}
+
+	account := utils.PathSearch(fmt.Sprintf("accounts[?account_id=='%s']|[0]", accountId), getAccountRespBody, nil)
+	if account == nil || utils.PathSearch("status", account, "") == "DELETED" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return account, nil
+}
+
+func waitForDcsAccountAvailable(ctx context.Context, client *golangsdk.ServiceClient, instanceId, accountId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING", "UPDATING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (interface{}, string, error) {
+			account, err := getDcsAccount(client, instanceId, accountId)
+			if err != nil {
+				return nil, "", err
+			}
+			return account, utils.PathSearch("status", account, "").(string), nil
+		},
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for DCS account (%s) to become available: %s", accountId, err)
+	}
+	return nil
+}
+
+func resourceDcsAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getAccount: Query DCS account
+	getAccountProduct := "dcs"
+	getAccountClient, err := cfg.NewServiceClient(getAccountProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DCS Client: %s", err)
+	}
+
+	account, err := getDcsAccount(getAccountClient, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DCS account")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("account_name", utils.PathSearch("account_name", account, nil)),
+		d.Set("account_role", utils.PathSearch("account_role", account, nil)),
+		d.Set("description", utils.PathSearch("description", account, nil)),
+		d.Set("account_type", utils.PathSearch("account_type", account, nil)),
+		d.Set("status", utils.PathSearch("status", account, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDcsAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	updateAccountProduct := "dcs"
+	updateAccountClient, err := cfg.NewServiceClient(updateAccountProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DCS Client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	updateAccountOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+	}
+
+	// updateAccountRole: update the permission and the description of the DCS account
+	if d.HasChanges("account_role", "description") {
+		updateAccountRoleHttpUrl := "v2/{project_id}/instances/{instance_id}/accounts/{account_id}/role"
+		updateAccountRolePath := buildDcsAccountPath(updateAccountClient, updateAccountRoleHttpUrl, instanceId, d.Id())
+		updateAccountOpt.JSONBody = map[string]interface{}{
+			"account_role": d.Get("account_role"),
+			"description":  d.Get("description"),
+		}
+		_, err = updateAccountClient.Request("PUT", updateAccountRolePath, &updateAccountOpt)
+		if err != nil {
+			return diag.Errorf("error updating the role of DCS account (%s): %s", d.Id(), err)
+		}
+		err = waitForDcsAccountAvailable(ctx, updateAccountClient, instanceId, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// updateAccountPassword: update the password of the DCS account
+	if d.HasChange("account_password") {
+		updateAccountPasswordHttpUrl := "v2/{project_id}/instances/{instance_id}/accounts/{account_id}/password"
+		updateAccountPasswordPath := buildDcsAccountPath(updateAccountClient, updateAccountPasswordHttpUrl,
+			instanceId, d.Id())
+		oldPassword, newPassword := d.GetChange("account_password")
+		updateAccountOpt.JSONBody = map[string]interface{}{
+			"old_password": oldPassword,
+			"new_password": newPassword,
+		}
+		_, err = updateAccountClient.Request("PUT", updateAccountPasswordPath, &updateAccountOpt)
+		if err != nil {
+			return diag.Errorf("error updating the password of DCS account (%s): %s", d.Id(), err)
+		}
+		err = waitForDcsAccountAvailable(ctx, updateAccountClient, instanceId, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDcsAccountRead(ctx, d, meta)
+}
+
+func resourceDcsAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteAccount: delete DCS account
+	var (
+		deleteAccountHttpUrl = "v2/{project_id}/instances/{instance_id}/accounts/{account_id}"
+		deleteAccountProduct = "dcs"
+	)
+	deleteAccountClient, err := cfg.NewServiceClient(deleteAccountProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DCS Client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	deleteAccountPath := buildDcsAccountPath(deleteAccountClient, deleteAccountHttpUrl, instanceId, d.Id())
+	deleteAccountOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+	}
+	_, err = deleteAccountClient.Request("DELETE", deleteAccountPath, &deleteAccountOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DCS account")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			account, err := getDcsAccount(deleteAccountClient, instanceId, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "", err
+			}
+			return account, utils.PathSearch("status", account, "").(string), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DCS account (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDcsAccountImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<account_id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
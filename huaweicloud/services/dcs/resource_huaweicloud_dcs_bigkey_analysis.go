@@ -0,0 +1,312 @@
+package dcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDcsBigkeyAnalysis() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcsBigkeyAnalysisCreate,
+		ReadContext:   resourceDcsBigkeyAnalysisRead,
+		DeleteContext: resourceDcsBigkeyAnalysisDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDcsKeyAnalysisImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: dcsKeyAnalysisSchema(false),
+	}
+}
+
+// dcsKeyAnalysisSchema returns the schema of the big key and the hot key analysis resources, the keys of the hot key
+// analysis contain the access frequency.
+func dcsKeyAnalysisSchema(withFreq bool) map[string]*schema.Schema {
+	keySchema := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the name of the key.`,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the type of the key.`,
+		},
+		"shard": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the shard where the key is located.`,
+		},
+		"db": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: `Indicates the database where the key is located.`,
+		},
+		"size": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: `Indicates the size of the key value.`,
+		},
+		"unit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the unit of the key size.`,
+		},
+	}
+	if withFreq {
+		keySchema["freq"] = &schema.Schema{
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: `Indicates the access frequency of the key within a specific period of time.`,
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		"instance_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: `Specifies the ID of the DCS instance.`,
+		},
+		"scan_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the mode of the analysis.`,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the status of the analysis.`,
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the time when the analysis task is created.`,
+		},
+		"started_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the time when the analysis task is started.`,
+		},
+		"finished_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `Indicates the time when the analysis task is finished.`,
+		},
+		"num": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: `Indicates the number of the keys found by the analysis.`,
+		},
+		"keys": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: `Indicates the keys found by the analysis.`,
+			Elem: &schema.Resource{
+				Schema: keySchema,
+			},
+		},
+	}
+}
+
+func buildDcsKeyAnalysisPath(client *golangsdk.ServiceClient, taskType, instanceId, taskId string) string {
+	path := client.Endpoint + "v2/{project_id}/instances/{instance_id}/{task_type}"
+	if taskId != "" {
+		path += "/" + taskId
+	}
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceId)
+	return strings.ReplaceAll(path, "{task_type}", taskType)
+}
+
+func getDcsKeyAnalysis(client *golangsdk.ServiceClient, taskType, instanceId, taskId string) (interface{}, error) {
+	getTaskOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getTaskResp, err := client.Request("GET", buildDcsKeyAnalysisPath(client, taskType, instanceId, taskId),
+		&getTaskOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getTaskResp)
+}
+
+// createDcsKeyAnalysis creates the analysis task of the taskType, which is bigkey-task or hotkey-task, and waits for
+// the scan to be completed.
+func createDcsKeyAnalysis(ctx context.Context, d *schema.ResourceData, meta interface{}, taskType string) error {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createTask: create the DCS key analysis task
+	createTaskProduct := "dcs"
+	createTaskClient, err := cfg.NewServiceClient(createTaskProduct, region)
+	if err != nil {
+		return fmt.Errorf("error creating DCS Client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createTaskOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201,
+		},
+	}
+	createTaskResp, err := createTaskClient.Request("POST",
+		buildDcsKeyAnalysisPath(createTaskClient, taskType, instanceId, ""), &createTaskOpt)
+	if err != nil {
+		return fmt.Errorf("error creating DCS key analysis: %s", err)
+	}
+	createTaskRespBody, err := utils.FlattenResponse(createTaskResp)
+	if err != nil {
+		return err
+	}
+	id := utils.PathSearch("id", createTaskRespBody, "").(string)
+	if id == "" {
+		return fmt.Errorf("error creating DCS key analysis: id is not found in API response")
+	}
+	d.SetId(id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"waiting", "running"},
+		Target:  []string{"success"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := getDcsKeyAnalysis(createTaskClient, taskType, instanceId, id)
+			if err != nil {
+				return nil, "", err
+			}
+			status := utils.PathSearch("status", resp, "").(string)
+			if status == "failed" {
+				return resp, status, fmt.Errorf("the analysis task is failed")
+			}
+			return resp, status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for DCS key analysis (%s) to be completed: %s", id, err)
+	}
+	return nil
+}
+
+func readDcsKeyAnalysis(d *schema.ResourceData, meta interface{}, taskType string) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getTask: Query the DCS key analysis task
+	getTaskProduct := "dcs"
+	getTaskClient, err := cfg.NewServiceClient(getTaskProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DCS Client: %s", err)
+	}
+
+	task, err := getDcsKeyAnalysis(getTaskClient, taskType, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DCS key analysis")
+	}
+
+	keys := utils.PathSearch("keys", task, make([]interface{}, 0)).([]interface{})
+	keyList := make([]map[string]interface{}, len(keys))
+	for i, key := range keys {
+		keyList[i] = map[string]interface{}{
+			"name":  utils.PathSearch("name", key, nil),
+			"type":  utils.PathSearch("type", key, nil),
+			"shard": utils.PathSearch("shard", key, nil),
+			"db":    utils.PathSearch("db", key, nil),
+			"size":  utils.PathSearch("size", key, nil),
+			"unit":  utils.PathSearch("unit", key, nil),
+		}
+		if taskType == "hotkey-task" {
+			keyList[i]["freq"] = utils.PathSearch("freq", key, nil)
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("scan_type", utils.PathSearch("scan_type", task, nil)),
+		d.Set("status", utils.PathSearch("status", task, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", task, nil)),
+		d.Set("started_at", utils.PathSearch("started_at", task, nil)),
+		d.Set("finished_at", utils.PathSearch("finished_at", task, nil)),
+		d.Set("num", utils.PathSearch("num", task, nil)),
+		d.Set("keys", keyList),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func deleteDcsKeyAnalysis(d *schema.ResourceData, meta interface{}, taskType string) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteTask: delete the DCS key analysis task
+	deleteTaskProduct := "dcs"
+	deleteTaskClient, err := cfg.NewServiceClient(deleteTaskProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DCS Client: %s", err)
+	}
+
+	deleteTaskOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+	}
+	_, err = deleteTaskClient.Request("DELETE",
+		buildDcsKeyAnalysisPath(deleteTaskClient, taskType, d.Get("instance_id").(string), d.Id()), &deleteTaskOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DCS key analysis")
+	}
+	return nil
+}
+
+func resourceDcsKeyAnalysisImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
+
+func resourceDcsBigkeyAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := createDcsKeyAnalysis(ctx, d, meta, "bigkey-task"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceDcsBigkeyAnalysisRead(ctx, d, meta)
+}
+
+func resourceDcsBigkeyAnalysisRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readDcsKeyAnalysis(d, meta, "bigkey-task")
+}
+
+func resourceDcsBigkeyAnalysisDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return deleteDcsKeyAnalysis(d, meta, "bigkey-task")
+}
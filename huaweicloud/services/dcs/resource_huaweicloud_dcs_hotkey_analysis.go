@@ -0,0 +1,42 @@
+package dcs
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceDcsHotkeyAnalysis is the impl of huaweicloud_dcs_hotkey_analysis resource. The hot key analysis is only
+// available to the instances whose maxmemory-policy is allkeys-lfu or volatile-lfu.
+func ResourceDcsHotkeyAnalysis() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcsHotkeyAnalysisCreate,
+		ReadContext:   resourceDcsHotkeyAnalysisRead,
+		DeleteContext: resourceDcsHotkeyAnalysisDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDcsKeyAnalysisImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: dcsKeyAnalysisSchema(true),
+	}
+}
+
+func resourceDcsHotkeyAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := createDcsKeyAnalysis(ctx, d, meta, "hotkey-task"); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceDcsHotkeyAnalysisRead(ctx, d, meta)
+}
+
+func resourceDcsHotkeyAnalysisRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readDcsKeyAnalysis(d, meta, "hotkey-task")
+}
+
+func resourceDcsHotkeyAnalysisDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return deleteDcsKeyAnalysis(d, meta, "hotkey-task")
+}
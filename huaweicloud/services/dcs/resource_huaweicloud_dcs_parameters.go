@@ -0,0 +1,363 @@
+package dcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDcsParameters() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcsParametersCreateOrUpdate,
+		ReadContext:   resourceDcsParametersRead,
+		UpdateContext: resourceDcsParametersCreateOrUpdate,
+		DeleteContext: resourceDcsParametersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDcsParametersImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DCS instance.`,
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the parameters to be modified, the key is the parameter name.`,
+			},
+			"restart_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: `Specifies whether to restart the instance when the modified parameters need a restart to ` +
+					`take effect.`,
+			},
+			"configuration_parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Indicates the list of all parameters of the instance.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the parameter.`,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the value of the parameter.`,
+						},
+						"default_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the default value of the parameter.`,
+						},
+						"value_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the type of the parameter value.`,
+						},
+						"value_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the allowed values of the parameter.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the description of the parameter.`,
+						},
+						"need_restart": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the instance needs a restart for the parameter to take effect.`,
+						},
+						"user_permission": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the permission of the parameter.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildDcsParametersPath(client *golangsdk.ServiceClient, httpUrl, instanceId string) string {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceId)
+}
+
+func getDcsParameters(client *golangsdk.ServiceClient, instanceId string) (interface{}, error) {
+	getParametersHttpUrl := "v2/{project_id}/instances/{instance_id}/configs"
+	getParametersPath := buildDcsParametersPath(client, getParametersHttpUrl, instanceId)
+	getParametersOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getParametersResp, err := client.Request("GET", getParametersPath, &getParametersOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getParametersResp)
+}
+
+func resourceDcsParametersCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// updateParameters: modify the parameters of the DCS instance
+	var (
+		updateParametersHttpUrl = "v2/{project_id}/instances/{instance_id}/configs"
+		updateParametersProduct = "dcs"
+	)
+	updateParametersClient, err := cfg.NewServiceClient(updateParametersProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DCS Client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	// The parameter IDs are required, they are fetched from the parameter list of the instance.
+	configs, err := getDcsParameters(updateParametersClient, instanceId)
+	if err != nil {
+		return diag.Errorf("error retrieving DCS instance parameters: %s", err)
+	}
+
+	var (
+		redisConfig  = make([]map[string]interface{}, 0)
+		restartNames = make([]string, 0)
+		o, n         = d.GetChange("parameters")
+		oldParams    = o.(map[string]interface{})
+	)
+	for name, value := range n.(map[string]interface{}) {
+		if oldValue, ok := oldParams[name]; ok && oldValue == value && !d.IsNewResource() {
+			continue
+		}
+		param := utils.PathSearch(fmt.Sprintf("redis_config[?param_name=='%s']|[0]", name), configs, nil)
+		if param == nil {
+			return diag.Errorf("parameter (%s) is not found in DCS instance (%s)", name, instanceId)
+		}
+		redisConfig = append(redisConfig, map[string]interface{}{
+			"param_id":    utils.PathSearch("param_id", param, nil),
+			"param_name":  name,
+			"param_value": value,
+		})
+		if utils.PathSearch("need_restart", param, false) == true {
+			restartNames = append(restartNames, name)
+		}
+	}
+
+	if len(redisConfig) > 0 {
+		updateParametersPath := buildDcsParametersPath(updateParametersClient, updateParametersHttpUrl, instanceId)
+		updateParametersOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200, 204,
+			},
+			JSONBody: map[string]interface{}{
+				"redis_config": redisConfig,
+			},
+		}
+		_, err = updateParametersClient.Request("PUT", updateParametersPath, &updateParametersOpt)
+		if err != nil {
+			return diag.Errorf("error modifying DCS instance parameters: %s", err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"UPDATING"},
+			Target:  []string{"SUCCESS"},
+			Refresh: func() (interface{}, string, error) {
+				resp, err := getDcsParameters(updateParametersClient, instanceId)
+				if err != nil {
+					return nil, "", err
+				}
+				status := utils.PathSearch("config_status", resp, "").(string)
+				if status == "FAILURE" {
+					return resp, status, fmt.Errorf("failed to modify the parameters")
+				}
+				return resp, status, nil
+			},
+			Timeout:      timeout,
+			Delay:        5 * time.Second,
+			PollInterval: 5 * time.Second,
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("error waiting for DCS instance (%s) parameters to be modified: %s", instanceId, err)
+		}
+	}
+
+	d.SetId(instanceId)
+
+	var diags diag.Diagnostics
+	if len(restartNames) > 0 {
+		if d.Get("restart_on_parameter_change").(bool) {
+			if err = restartDcsInstance(ctx, cfg, region, instanceId, timeout); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Parameters Changed",
+				Detail: fmt.Sprintf("Parameters %s changed which needs restart, the changes are pending until "+
+					"the instance is restarted.", restartNames),
+			})
+		}
+	}
+
+	return append(diags, resourceDcsParametersRead(ctx, d, meta)...)
+}
+
+func restartDcsInstance(ctx context.Context, cfg *config.Config, region, instanceId string,
+	timeout time.Duration) error {
+	// restartInstance: restart the DCS instance
+	var (
+		restartInstanceHttpUrl = "v2/{project_id}/instances/status"
+		restartInstanceProduct = "dcs"
+	)
+	restartInstanceClient, err := cfg.NewServiceClient(restartInstanceProduct, region)
+	if err != nil {
+		return fmt.Errorf("error creating DCS Client: %s", err)
+	}
+
+	restartInstancePath := buildDcsParametersPath(restartInstanceClient, restartInstanceHttpUrl, instanceId)
+	restartInstanceOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+		JSONBody: map[string]interface{}{
+			"instances": []string{instanceId},
+			"action":    "restart",
+		},
+	}
+	_, err = restartInstanceClient.Request("PUT", restartInstancePath, &restartInstanceOpt)
+	if err != nil {
+		return fmt.Errorf("error restarting DCS instance (%s): %s", instanceId, err)
+	}
+
+	client, err := cfg.DcsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating DCS Client: %s", err)
+	}
+	return waitForDcsInstanceCompleted(ctx, client, instanceId, timeout, []string{"RESTARTING"}, []string{"RUNNING"})
+}
+
+func resourceDcsParametersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getParameters: Query the parameters of the DCS instance
+	getParametersProduct := "dcs"
+	getParametersClient, err := cfg.NewServiceClient(getParametersProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DCS Client: %s", err)
+	}
+
+	configs, err := getDcsParameters(getParametersClient, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DCS instance parameters")
+	}
+
+	params := make(map[string]interface{})
+	for name := range d.Get("parameters").(map[string]interface{}) {
+		param := utils.PathSearch(fmt.Sprintf("redis_config[?param_name=='%s']|[0]", name), configs, nil)
+		if param != nil {
+			params[name] = utils.PathSearch("param_value", param, nil)
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", d.Id()),
+		d.Set("parameters", params),
+		d.Set("configuration_parameters", flattenDcsConfigurationParameters(configs)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenDcsConfigurationParameters(resp interface{}) []map[string]interface{} {
+	configs := utils.PathSearch("redis_config", resp, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(configs))
+	for i, v := range configs {
+		result[i] = map[string]interface{}{
+			"name":            utils.PathSearch("param_name", v, nil),
+			"value":           utils.PathSearch("param_value", v, nil),
+			"default_value":   utils.PathSearch("default_value", v, nil),
+			"value_type":      utils.PathSearch("value_type", v, nil),
+			"value_range":     utils.PathSearch("value_range", v, nil),
+			"description":     utils.PathSearch("description", v, nil),
+			"need_restart":    utils.PathSearch("need_restart", v, nil),
+			"user_permission": utils.PathSearch("user_permission", v, nil),
+		}
+	}
+	return result
+}
+
+func resourceDcsParametersDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the DCS parameters resource is not supported. The resource is only removed from the " +
+		"state, but the parameters remain modified in the instance."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
+
+func resourceDcsParametersImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dcs", cfg.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating DCS Client: %s", err)
+	}
+
+	// All parameters which are modifiable by users are imported.
+	configs, err := getDcsParameters(client, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DCS instance parameters: %s", err)
+	}
+	params := make(map[string]interface{})
+	for _, v := range utils.PathSearch("redis_config", configs, make([]interface{}, 0)).([]interface{}) {
+		if utils.PathSearch("user_permission", v, "") == "modify" {
+			params[utils.PathSearch("param_name", v, "").(string)] = utils.PathSearch("param_value", v, nil)
+		}
+	}
+	return []*schema.ResourceData{d}, d.Set("parameters", params)
+}
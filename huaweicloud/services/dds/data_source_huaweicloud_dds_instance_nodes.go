@@ -0,0 +1,220 @@
+package dds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceDdsInstanceNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDdsInstanceNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the DDS instance.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the type of the node group.`,
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the role of the nodes.`,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the ID of the node.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the node.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the type of the node group.`,
+						},
+						"group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the ID of the node group.`,
+						},
+						"group_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the node group.`,
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the role of the node.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the status of the node.`,
+						},
+						"spec_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the resource specification code of the node.`,
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the availability zone of the node.`,
+						},
+						"private_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the private IP address of the node.`,
+						},
+						"public_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the EIP bound to the node.`,
+						},
+						"private_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the private connection address of the node.`,
+						},
+						"public_address": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the public connection address of the node.`,
+						},
+					},
+				},
+				Description: `Indicates the list of the nodes.`,
+			},
+		},
+	}
+}
+
+func dataSourceDdsInstanceNodesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getDdsInstance: query the nodes of the DDS instance
+	var (
+		getDdsInstanceHttpUrl = "v3/{project_id}/instances?id={instance_id}"
+		getDdsInstanceProduct = "dds"
+	)
+	getDdsInstanceClient, err := cfg.NewServiceClient(getDdsInstanceProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DDS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	getDdsInstancePath := getDdsInstanceClient.Endpoint + getDdsInstanceHttpUrl
+	getDdsInstancePath = strings.ReplaceAll(getDdsInstancePath, "{project_id}", getDdsInstanceClient.ProjectID)
+	getDdsInstancePath = strings.ReplaceAll(getDdsInstancePath, "{instance_id}", instanceID)
+
+	getDdsInstanceOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getDdsInstanceResp, err := getDdsInstanceClient.Request("GET", getDdsInstancePath, &getDdsInstanceOpt)
+	if err != nil {
+		return diag.Errorf("error retrieving DDS instance (%s): %s", instanceID, err)
+	}
+
+	getDdsInstanceRespBody, err := utils.FlattenResponse(getDdsInstanceResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance := utils.PathSearch("instances|[0]", getDdsInstanceRespBody, nil)
+	if instance == nil {
+		return diag.Errorf("unable to find the DDS instance (%s)", instanceID)
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("nodes", flattenDdsInstanceNodes(d, instance)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func buildDdsNodeAddress(ip, port string) string {
+	if ip == "" || port == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%s", ip, port)
+}
+
+func flattenDdsInstanceNodes(d *schema.ResourceData, instance interface{}) []interface{} {
+	port := utils.PathSearch("port", instance, "").(string)
+	groups := utils.PathSearch("groups", instance, make([]interface{}, 0)).([]interface{})
+	rst := make([]interface{}, 0)
+	for _, group := range groups {
+		groupType := utils.PathSearch("type", group, "").(string)
+		if filterType, ok := d.GetOk("type"); ok && filterType.(string) != groupType {
+			continue
+		}
+
+		nodes := utils.PathSearch("nodes", group, make([]interface{}, 0)).([]interface{})
+		for _, v := range nodes {
+			role := utils.PathSearch("role", v, "").(string)
+			if filterRole, ok := d.GetOk("role"); ok && !strings.EqualFold(filterRole.(string), role) {
+				continue
+			}
+
+			privateIP := utils.PathSearch("private_ip", v, "").(string)
+			publicIP := utils.PathSearch("public_ip", v, "").(string)
+			rst = append(rst, map[string]interface{}{
+				"id":                utils.PathSearch("id", v, nil),
+				"name":              utils.PathSearch("name", v, nil),
+				"type":              groupType,
+				"group_id":          utils.PathSearch("id", group, nil),
+				"group_name":        utils.PathSearch("name", group, nil),
+				"role":              role,
+				"status":            utils.PathSearch("status", v, nil),
+				"spec_code":         utils.PathSearch("spec_code", v, nil),
+				"availability_zone": utils.PathSearch("availability_zone", v, nil),
+				"private_ip":        privateIP,
+				"public_ip":         publicIP,
+				"private_address":   buildDdsNodeAddress(privateIP, port),
+				"public_address":    buildDdsNodeAddress(publicIP, port),
+			})
+		}
+	}
+	return rst
+}
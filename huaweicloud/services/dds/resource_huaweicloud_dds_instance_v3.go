@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/chnsz/golangsdk"
@@ -33,6 +34,8 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceDdsInstanceV3CustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -133,22 +136,23 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			"configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"id": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
 			},
+			"restart_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"flavor": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -186,6 +190,24 @@ func ResourceDdsInstanceV3() *schema.Resource {
 					},
 				},
 			},
+			"readonly_node": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"num": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 5),
+						},
+						"spec_code": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"backup_strategy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -467,6 +489,13 @@ func resourceDdsInstanceV3Create(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if num := d.Get("readonly_node.0.num").(int); num > 0 {
+		err = readonlyNodeNumUpdate(ctx, config, client, d, 0, num)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDdsInstanceV3Read(ctx, d, meta)
 }
 
@@ -511,6 +540,7 @@ func resourceDdsInstanceV3Read(_ context.Context, d *schema.ResourceData, meta i
 		d.Set("status", instance.Status),
 		d.Set("enterprise_project_id", instance.EnterpriseProjectID),
 		d.Set("nodes", flattenDdsInstanceV3Nodes(instance)),
+		d.Set("readonly_node", flattenDdsInstanceV3ReadonlyNode(instance)),
 	)
 
 	port, err := strconv.Atoi(instance.Port)
@@ -588,6 +618,20 @@ func waitForInstanceReady(ctx context.Context, client *golangsdk.ServiceClient,
 	return nil
 }
 
+// resourceDdsInstanceV3CustomizeDiff rejects changing the specification of the existing read-only nodes, the
+// read-only nodes can only be added or deleted in place.
+func resourceDdsInstanceV3CustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("readonly_node.0.spec_code") {
+		return nil
+	}
+	oldSpecCode, newSpecCode := d.GetChange("readonly_node.0.spec_code")
+	if oldSpecCode.(string) != "" && newSpecCode.(string) != "" {
+		return fmt.Errorf("the spec_code of the read-only nodes can not be changed from %s to %s, please delete "+
+			"the read-only nodes first", oldSpecCode, newSpecCode)
+	}
+	return nil
+}
+
 func resourceDdsInstanceV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	client, err := config.DdsV3Client(config.GetRegion(d))
@@ -747,7 +791,32 @@ func resourceDdsInstanceV3Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
-	return resourceDdsInstanceV3Read(ctx, d, meta)
+	if d.HasChange("readonly_node") {
+		oldNum, newNum := d.GetChange("readonly_node.0.num")
+		err = readonlyNodeNumUpdate(ctx, config, client, d, oldNum.(int), newNum.(int))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	var pendingParams []string
+	if d.HasChange("configuration") {
+		pendingParams, err = configurationUpdate(ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	diags := resourceDdsInstanceV3Read(ctx, d, meta)
+	if len(pendingParams) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Parameters Changed",
+			Detail: fmt.Sprintf("Parameters %s changed which needs restart, the changes are pending until the "+
+				"nodes are restarted.", pendingParams),
+		})
+	}
+	return diags
 }
 
 func resourceDdsInstanceV3Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -810,6 +879,51 @@ func flattenDdsInstanceV3Nodes(dds instances.InstanceResponse) interface{} {
 	return nodesList
 }
 
+// flattenDdsInstanceV3ReadonlyNode returns the read-only nodes of the replica set instance, the spec code of the first
+// read-only node is used since all read-only nodes are added with the same spec code.
+func flattenDdsInstanceV3ReadonlyNode(dds instances.InstanceResponse) []map[string]interface{} {
+	readonlyNodes := getDdsInstanceV3ReadonlyNodes(dds)
+	if len(readonlyNodes) == 0 {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"num":       len(readonlyNodes),
+			"spec_code": readonlyNodes[0].SpecCode,
+		},
+	}
+}
+
+func getDdsInstanceV3ReadonlyNodes(dds instances.InstanceResponse) []instances.Nodes {
+	readonlyNodes := make([]instances.Nodes, 0)
+	for _, group := range dds.Groups {
+		for _, node := range group.Nodes {
+			if strings.EqualFold(node.Role, "readonly") {
+				readonlyNodes = append(readonlyNodes, node)
+			}
+		}
+	}
+	return readonlyNodes
+}
+
+func getDdsInstanceV3ByID(client *golangsdk.ServiceClient, instanceID string) (*instances.InstanceResponse, error) {
+	opts := instances.ListInstanceOpts{
+		Id: instanceID,
+	}
+	allPages, err := instances.List(client, &opts).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+	instanceList, err := instances.ExtractInstances(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting DDS instance: %s", err)
+	}
+	if instanceList.TotalCount == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return &instanceList.Instances[0], nil
+}
+
 func getDdsInstanceV3ShardGroupID(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]string, error) {
 	groupIDs := make([]string, 0)
 
@@ -920,7 +1034,7 @@ func flavorNumUpdate(ctx context.Context, config *config.Config, client *golangs
 	oldNum := oldNumRaw.(int)
 	newNum := newNumRaw.(int)
 	if newNum < oldNum {
-		return fmtp.Errorf("Error updating instance: the new num(%d) must be greater than the old num(%d)", newNum, oldNum)
+		return flavorNumReduce(ctx, client, d, groupType, oldNum-newNum)
 	}
 
 	var numUpdateOpts []instances.UpdateOpt
@@ -1136,3 +1250,282 @@ func flavorSpecCodeUpdate(ctx context.Context, config *config.Config, client *go
 	}
 	return nil
 }
+
+func waitForJobCompleted(ctx context.Context, client *golangsdk.ServiceClient, jobId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Running"},
+		Target:       []string{"Completed"},
+		Refresh:      JobStateRefreshFunc(client, jobId),
+		Timeout:      timeout,
+		PollInterval: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for the job (%s) completed: %s ", jobId, err)
+	}
+	return nil
+}
+
+// reduceNodes deletes the mongos nodes, the shard groups or the read-only nodes from the instance. The node list
+// contains the node IDs for mongos and read-only nodes and the group IDs for shards.
+func reduceNodes(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, nodeType string,
+	nodeList []string) error {
+	reduceOpts := map[string]interface{}{
+		"type":      nodeType,
+		"node_list": nodeList,
+	}
+	logp.Printf("[DEBUG] Reduce nodes of the instance %s options: %+v", d.Id(), reduceOpts)
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("instances", d.Id(), "reduce-node"), reduceOpts, &r.Body,
+		&golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+	if r.Err != nil {
+		return fmt.Errorf("error deleting %s nodes (%v) of the instance %s: %s", nodeType, nodeList, d.Id(), r.Err)
+	}
+
+	if jobId := utils.PathSearch("job_id", r.Body, "").(string); jobId != "" {
+		if err := waitForJobCompleted(ctx, client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	return waitForInstanceReady(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
+
+// flavorNumReduce deletes the last mongos nodes or shard groups of the cluster instance.
+func flavorNumReduce(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, groupType string,
+	num int) error {
+	var (
+		ids []string
+		err error
+	)
+	if groupType == "mongos" {
+		ids, err = getDdsInstanceV3MongosNodeID(client, d)
+	} else {
+		ids, err = getDdsInstanceV3ShardGroupID(client, d)
+	}
+	if err != nil {
+		return err
+	}
+	if num >= len(ids) {
+		return fmt.Errorf("error updating instance: the instance only has %d %s nodes, can not delete %d of them",
+			len(ids), groupType, num)
+	}
+	return reduceNodes(ctx, client, d, groupType, ids[len(ids)-num:])
+}
+
+// readonlyNodeNumUpdate adds the read-only nodes to the replica set instance or deletes the last read-only nodes.
+func readonlyNodeNumUpdate(ctx context.Context, config *config.Config, client *golangsdk.ServiceClient,
+	d *schema.ResourceData, oldNum, newNum int) error {
+	if d.Get("mode").(string) != "ReplicaSet" {
+		return fmt.Errorf("error updating instance: only the replica set instance supports the read-only nodes")
+	}
+
+	if newNum > oldNum {
+		updateNodeNumOpts := instances.UpdateNodeNumOpts{
+			Type:     "readonly",
+			SpecCode: d.Get("readonly_node.0.spec_code").(string),
+			Num:      newNum - oldNum,
+		}
+		if d.Get("charging_mode").(string) == "prePaid" && d.Get("auto_pay").(string) != "false" {
+			updateNodeNumOpts.IsAutoPay = true
+		}
+		opt := instances.UpdateOpt{
+			Param:  "",
+			Value:  updateNodeNumOpts,
+			Action: "enlarge",
+			Method: "post",
+		}
+		return flavorUpdate(ctx, config, client, d, []instances.UpdateOpt{opt})
+	}
+
+	instance, err := getDdsInstanceV3ByID(client, d.Id())
+	if err != nil {
+		return err
+	}
+	readonlyNodes := getDdsInstanceV3ReadonlyNodes(*instance)
+	if len(readonlyNodes) < oldNum-newNum {
+		return fmt.Errorf("error updating instance: the instance only has %d read-only nodes, can not delete %d "+
+			"of them", len(readonlyNodes), oldNum-newNum)
+	}
+	nodeList := make([]string, 0, oldNum-newNum)
+	for _, node := range readonlyNodes[len(readonlyNodes)-(oldNum-newNum):] {
+		nodeList = append(nodeList, node.Id)
+	}
+	return reduceNodes(ctx, client, d, "readonly", nodeList)
+}
+
+// getConfigurationEntityIDs returns the IDs of the entities to which the parameter template of the node type is
+// applied: the node IDs for mongos, the group IDs for shard and config, and the instance ID for the others.
+func getConfigurationEntityIDs(instance *instances.InstanceResponse, nodeType string) []string {
+	entityIDs := make([]string, 0)
+	switch nodeType {
+	case "mongos":
+		for _, group := range instance.Groups {
+			if group.Type != nodeType {
+				continue
+			}
+			for _, node := range group.Nodes {
+				entityIDs = append(entityIDs, node.Id)
+			}
+		}
+	case "shard", "config":
+		for _, group := range instance.Groups {
+			if group.Type == nodeType {
+				entityIDs = append(entityIDs, group.Id)
+			}
+		}
+	default:
+		entityIDs = append(entityIDs, instance.Id)
+	}
+	return entityIDs
+}
+
+func restartEntity(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData, nodeType,
+	entityID string) error {
+	restartOpts := map[string]interface{}{
+		"target_id": entityID,
+	}
+	if nodeType == "mongos" || nodeType == "shard" || nodeType == "config" {
+		restartOpts["target_type"] = nodeType
+	}
+	logp.Printf("[DEBUG] Restart the instance %s options: %+v", d.Id(), restartOpts)
+
+	var r golangsdk.Result
+	_, r.Err = client.Post(client.ServiceURL("instances", d.Id(), "restart"), restartOpts, &r.Body,
+		&golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+	if r.Err != nil {
+		return fmt.Errorf("error restarting %s (%s) of the instance %s: %s", nodeType, entityID, d.Id(), r.Err)
+	}
+	if jobId := utils.PathSearch("job_id", r.Body, "").(string); jobId != "" {
+		if err := waitForJobCompleted(ctx, client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	return waitForInstanceReady(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
+
+// getConfigurationParameters returns the parameters of the parameter template.
+func getConfigurationParameters(client *golangsdk.ServiceClient, configId string) ([]interface{}, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("configurations", configId), &r.Body, nil)
+	if r.Err != nil {
+		return nil, fmt.Errorf("error retrieving the parameters of template %s: %s", configId, r.Err)
+	}
+	return utils.PathSearch("parameters", r.Body, make([]interface{}, 0)).([]interface{}), nil
+}
+
+// getRestartRequiredParameters returns the parameters which need a restart and are changed by switching the
+// parameter template from oldConfigId to newConfigId. All the parameters of the new template which need a restart
+// are returned if the old template is unknown.
+func getRestartRequiredParameters(client *golangsdk.ServiceClient, oldConfigId, newConfigId string) ([]string,
+	error) {
+	newParams, err := getConfigurationParameters(client, newConfigId)
+	if err != nil {
+		return nil, err
+	}
+
+	oldValues := make(map[string]interface{})
+	if oldConfigId != "" {
+		oldParams, err := getConfigurationParameters(client, oldConfigId)
+		if err != nil {
+			logp.Printf("[WARN] %s", err)
+		}
+		for _, param := range oldParams {
+			oldValues[utils.PathSearch("name", param, "").(string)] = utils.PathSearch("value", param, nil)
+		}
+	}
+
+	var restart []string
+	for _, param := range newParams {
+		if !utils.PathSearch("restart_required", param, false).(bool) {
+			continue
+		}
+		name := utils.PathSearch("name", param, "").(string)
+		if oldValue, ok := oldValues[name]; ok && oldValue == utils.PathSearch("value", param, nil) {
+			continue
+		}
+		restart = append(restart, name)
+	}
+	return restart, nil
+}
+
+// configurationUpdate applies the changed parameter templates to the nodes of the corresponding type and returns the
+// changed parameters which are pending until the nodes are restarted. The nodes are restarted one by one only if some
+// changed parameters need a restart and restart_on_parameter_change is enabled.
+func configurationUpdate(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData) ([]string,
+	error) {
+	oldRaw, newRaw := d.GetChange("configuration")
+	oldConfigurations := make(map[string]string)
+	for _, v := range oldRaw.([]interface{}) {
+		configuration := v.(map[string]interface{})
+		oldConfigurations[configuration["type"].(string)] = configuration["id"].(string)
+	}
+
+	instance, err := getDdsInstanceV3ByID(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingParams []string
+	for _, v := range newRaw.([]interface{}) {
+		configuration := v.(map[string]interface{})
+		nodeType := configuration["type"].(string)
+		configId := configuration["id"].(string)
+		if oldConfigurations[nodeType] == configId {
+			continue
+		}
+
+		entityIDs := getConfigurationEntityIDs(instance, nodeType)
+		if len(entityIDs) == 0 {
+			return nil, fmt.Errorf("error applying parameter template %s: the instance %s has no %s nodes", configId,
+				d.Id(), nodeType)
+		}
+		restartParams, err := getRestartRequiredParameters(client, oldConfigurations[nodeType], configId)
+		if err != nil {
+			return nil, err
+		}
+
+		applyOpts := map[string]interface{}{
+			"entity_ids": entityIDs,
+		}
+		logp.Printf("[DEBUG] Apply parameter template %s options: %+v", configId, applyOpts)
+
+		var r golangsdk.Result
+		_, r.Err = client.Put(client.ServiceURL("configurations", configId, "apply"), applyOpts, &r.Body,
+			&golangsdk.RequestOpts{
+				OkCodes: []int{200, 202},
+			})
+		if r.Err != nil {
+			return nil, fmt.Errorf("error applying parameter template %s to the %s nodes of the instance %s: %s",
+				configId, nodeType, d.Id(), r.Err)
+		}
+		if jobId := utils.PathSearch("job_id", r.Body, "").(string); jobId != "" {
+			if err = waitForJobCompleted(ctx, client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return nil, err
+			}
+		}
+		if err = waitForInstanceReady(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return nil, err
+		}
+
+		if len(restartParams) == 0 {
+			continue
+		}
+		if !d.Get("restart_on_parameter_change").(bool) {
+			pendingParams = append(pendingParams, restartParams...)
+			continue
+		}
+		for _, entityID := range entityIDs {
+			if err = restartEntity(ctx, client, d, nodeType, entityID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return pendingParams, nil
+}
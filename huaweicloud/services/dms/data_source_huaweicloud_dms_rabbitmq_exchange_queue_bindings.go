@@ -0,0 +1,132 @@
+package dms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceDmsRabbitmqExchangeQueueBindings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDmsRabbitmqExchangeQueueBindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the vhost to which the exchange belongs.`,
+			},
+			"exchange": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the source exchange.`,
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the destination queue or exchange.`,
+			},
+			"bindings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the type of the destination.`,
+						},
+						"destination": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the destination queue or exchange.`,
+						},
+						"routing_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the routing key of the binding.`,
+						},
+						"properties_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the properties key of the binding.`,
+						},
+					},
+				},
+				Description: `Indicates the list of the bindings.`,
+			},
+		},
+	}
+}
+
+func dataSourceDmsRabbitmqExchangeQueueBindingsRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// listRabbitmqBindings: query the list of the DMS RabbitMQ bindings of the exchange
+	var (
+		listRabbitmqBindingsHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/" +
+			"exchanges/{exchange}/binds"
+		listRabbitmqBindingsProduct = "dmsv2"
+	)
+	listRabbitmqBindingsClient, err := cfg.NewServiceClient(listRabbitmqBindingsProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	listRabbitmqBindingsPath := buildRabbitmqManagementPath(listRabbitmqBindingsClient, listRabbitmqBindingsHttpUrl,
+		d.Get("instance_id").(string), d.Get("vhost").(string))
+	listRabbitmqBindingsPath = strings.ReplaceAll(listRabbitmqBindingsPath, "{exchange}", d.Get("exchange").(string))
+
+	bindings, err := listRabbitmqManagementItems(listRabbitmqBindingsClient, listRabbitmqBindingsPath)
+	if err != nil {
+		return diag.Errorf("error retrieving DMS RabbitMQ bindings: %s", err)
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("bindings", flattenRabbitmqExchangeQueueBindings(d, bindings)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenRabbitmqExchangeQueueBindings(d *schema.ResourceData, bindings []interface{}) []interface{} {
+	rst := make([]interface{}, 0, len(bindings))
+	for _, v := range bindings {
+		destination := utils.PathSearch("destination", v, "").(string)
+		if filterDestination, ok := d.GetOk("destination"); ok && filterDestination.(string) != destination {
+			continue
+		}
+		rst = append(rst, map[string]interface{}{
+			"destination_type": utils.PathSearch("destination_type", v, nil),
+			"destination":      destination,
+			"routing_key":      utils.PathSearch("routing_key", v, nil),
+			"properties_key":   utils.PathSearch("properties_key", v, nil),
+		})
+	}
+	return rst
+}
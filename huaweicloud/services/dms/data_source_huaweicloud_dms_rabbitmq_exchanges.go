@@ -0,0 +1,137 @@
+package dms
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceDmsRabbitmqExchanges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDmsRabbitmqExchangesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the vhost to which the exchanges belong.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the exchange.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the type of the exchange.`,
+			},
+			"exchanges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the exchange.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the type of the exchange.`,
+						},
+						"auto_delete": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the exchange is deleted automatically.`,
+						},
+						"durable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the exchange survives a broker restart.`,
+						},
+						"internal": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the exchange is internal.`,
+						},
+					},
+				},
+				Description: `Indicates the list of the exchanges.`,
+			},
+		},
+	}
+}
+
+func dataSourceDmsRabbitmqExchangesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// listRabbitmqExchanges: query the list of the DMS RabbitMQ exchanges
+	var (
+		listRabbitmqExchangesHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges"
+		listRabbitmqExchangesProduct = "dmsv2"
+	)
+	listRabbitmqExchangesClient, err := cfg.NewServiceClient(listRabbitmqExchangesProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	exchanges, err := listRabbitmqManagementItems(listRabbitmqExchangesClient,
+		buildRabbitmqManagementPath(listRabbitmqExchangesClient, listRabbitmqExchangesHttpUrl,
+			d.Get("instance_id").(string), d.Get("vhost").(string)))
+	if err != nil {
+		return diag.Errorf("error retrieving DMS RabbitMQ exchanges: %s", err)
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("exchanges", flattenRabbitmqExchanges(d, exchanges)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenRabbitmqExchanges(d *schema.ResourceData, exchanges []interface{}) []interface{} {
+	rst := make([]interface{}, 0, len(exchanges))
+	for _, v := range exchanges {
+		name := utils.PathSearch("name", v, "").(string)
+		exchangeType := utils.PathSearch("type", v, "").(string)
+		if filterName, ok := d.GetOk("name"); ok && filterName.(string) != name {
+			continue
+		}
+		if filterType, ok := d.GetOk("type"); ok && filterType.(string) != exchangeType {
+			continue
+		}
+		rst = append(rst, map[string]interface{}{
+			"name":        name,
+			"type":        exchangeType,
+			"auto_delete": utils.PathSearch("auto_delete", v, nil),
+			"durable":     utils.PathSearch("durable", v, nil),
+			"internal":    utils.PathSearch("internal", v, nil),
+		})
+	}
+	return rst
+}
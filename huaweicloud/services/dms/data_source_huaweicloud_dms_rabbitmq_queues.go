@@ -0,0 +1,134 @@
+package dms
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceDmsRabbitmqQueues() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDmsRabbitmqQueuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the vhost to which the queues belong.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the queue.`,
+			},
+			"queues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the queue.`,
+						},
+						"auto_delete": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the queue is deleted automatically.`,
+						},
+						"durable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the queue survives a broker restart.`,
+						},
+						"messages": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the number of the messages in the queue.`,
+						},
+						"consumers": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the number of the consumers of the queue.`,
+						},
+						"policy": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the policy applied to the queue.`,
+						},
+					},
+				},
+				Description: `Indicates the list of the queues.`,
+			},
+		},
+	}
+}
+
+func dataSourceDmsRabbitmqQueuesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// listRabbitmqQueues: query the list of the DMS RabbitMQ queues
+	var (
+		listRabbitmqQueuesHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/queues"
+		listRabbitmqQueuesProduct = "dmsv2"
+	)
+	listRabbitmqQueuesClient, err := cfg.NewServiceClient(listRabbitmqQueuesProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	queues, err := listRabbitmqManagementItems(listRabbitmqQueuesClient,
+		buildRabbitmqManagementPath(listRabbitmqQueuesClient, listRabbitmqQueuesHttpUrl,
+			d.Get("instance_id").(string), d.Get("vhost").(string)))
+	if err != nil {
+		return diag.Errorf("error retrieving DMS RabbitMQ queues: %s", err)
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("queues", flattenRabbitmqQueues(d, queues)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenRabbitmqQueues(d *schema.ResourceData, queues []interface{}) []interface{} {
+	rst := make([]interface{}, 0, len(queues))
+	for _, v := range queues {
+		name := utils.PathSearch("name", v, "").(string)
+		if filterName, ok := d.GetOk("name"); ok && filterName.(string) != name {
+			continue
+		}
+		rst = append(rst, map[string]interface{}{
+			"name":        name,
+			"auto_delete": utils.PathSearch("auto_delete", v, nil),
+			"durable":     utils.PathSearch("durable", v, nil),
+			"messages":    utils.PathSearch("messages", v, nil),
+			"consumers":   utils.PathSearch("consumers", v, nil),
+			"policy":      utils.PathSearch("policy", v, nil),
+		})
+	}
+	return rst
+}
@@ -0,0 +1,105 @@
+package dms
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceDmsRabbitmqVhosts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDmsRabbitmqVhostsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the vhost.`,
+			},
+			"vhosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the vhost.`,
+						},
+						"tracing": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the message tracing is enabled.`,
+						},
+					},
+				},
+				Description: `Indicates the list of the vhosts.`,
+			},
+		},
+	}
+}
+
+func dataSourceDmsRabbitmqVhostsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// listRabbitmqVhosts: query the list of the DMS RabbitMQ vhosts
+	var (
+		listRabbitmqVhostsHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts"
+		listRabbitmqVhostsProduct = "dmsv2"
+	)
+	listRabbitmqVhostsClient, err := cfg.NewServiceClient(listRabbitmqVhostsProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	vhosts, err := listRabbitmqManagementItems(listRabbitmqVhostsClient,
+		buildRabbitmqManagementPath(listRabbitmqVhostsClient, listRabbitmqVhostsHttpUrl,
+			d.Get("instance_id").(string), ""))
+	if err != nil {
+		return diag.Errorf("error retrieving DMS RabbitMQ vhosts: %s", err)
+	}
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vhosts", flattenRabbitmqVhosts(d, vhosts)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenRabbitmqVhosts(d *schema.ResourceData, vhosts []interface{}) []interface{} {
+	rst := make([]interface{}, 0, len(vhosts))
+	for _, v := range vhosts {
+		name := utils.PathSearch("name", v, "").(string)
+		if filterName, ok := d.GetOk("name"); ok && filterName.(string) != name {
+			continue
+		}
+		rst = append(rst, map[string]interface{}{
+			"name":    name,
+			"tracing": utils.PathSearch("tracing", v, nil),
+		})
+	}
+	return rst
+}
@@ -0,0 +1,292 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsKafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaConsumerGroupCreate,
+		UpdateContext: resourceDmsKafkaConsumerGroupUpdate,
+		ReadContext:   resourceDmsKafkaConsumerGroupRead,
+		DeleteContext: resourceDmsKafkaConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the kafka instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the consumer group.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the description of the consumer group.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the state of the consumer group.`,
+			},
+			"coordinator_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the ID of the coordinator broker.`,
+			},
+			"lag": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the number of accumulated messages.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the consumer group is created.`,
+			},
+		},
+	}
+}
+
+func resourceDmsKafkaConsumerGroupCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createKafkaConsumerGroup: create DMS kafka consumer group
+	var (
+		createKafkaConsumerGroupHttpUrl = "v2/{project_id}/kafka/instances/{instance_id}/group"
+		createKafkaConsumerGroupProduct = "dmsv2"
+	)
+	createKafkaConsumerGroupClient, err := cfg.NewServiceClient(createKafkaConsumerGroupProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createKafkaConsumerGroupPath := createKafkaConsumerGroupClient.Endpoint + createKafkaConsumerGroupHttpUrl
+	createKafkaConsumerGroupPath = strings.ReplaceAll(createKafkaConsumerGroupPath, "{project_id}",
+		createKafkaConsumerGroupClient.ProjectID)
+	createKafkaConsumerGroupPath = strings.ReplaceAll(createKafkaConsumerGroupPath, "{instance_id}", instanceID)
+
+	createKafkaConsumerGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+	}
+	createKafkaConsumerGroupOpt.JSONBody = utils.RemoveNil(buildCreateKafkaConsumerGroupBodyParams(d))
+	_, err = createKafkaConsumerGroupClient.Request("POST", createKafkaConsumerGroupPath,
+		&createKafkaConsumerGroupOpt)
+	if err != nil {
+		return diag.Errorf("error creating DMS kafka consumer group: %s", err)
+	}
+
+	d.SetId(instanceID + "/" + d.Get("name").(string))
+
+	return resourceDmsKafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func buildCreateKafkaConsumerGroupBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"group_name": d.Get("name"),
+		"group_desc": utils.ValueIngoreEmpty(d.Get("description")),
+	}
+	return bodyParams
+}
+
+func resourceDmsKafkaConsumerGroupUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	if d.HasChange("description") {
+		// updateKafkaConsumerGroup: update DMS kafka consumer group
+		var (
+			updateKafkaConsumerGroupHttpUrl = "v2/kafka/{project_id}/instances/{instance_id}/groups/{group}"
+			updateKafkaConsumerGroupProduct = "dmsv2"
+		)
+		updateKafkaConsumerGroupClient, err := cfg.NewServiceClient(updateKafkaConsumerGroupProduct, region)
+		if err != nil {
+			return diag.Errorf("error creating DMS Client: %s", err)
+		}
+
+		instanceID, name, err := parseKafkaConsumerGroupID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updateKafkaConsumerGroupPath := updateKafkaConsumerGroupClient.Endpoint + updateKafkaConsumerGroupHttpUrl
+		updateKafkaConsumerGroupPath = strings.ReplaceAll(updateKafkaConsumerGroupPath, "{project_id}",
+			updateKafkaConsumerGroupClient.ProjectID)
+		updateKafkaConsumerGroupPath = strings.ReplaceAll(updateKafkaConsumerGroupPath, "{instance_id}", instanceID)
+		updateKafkaConsumerGroupPath = strings.ReplaceAll(updateKafkaConsumerGroupPath, "{group}", name)
+
+		updateKafkaConsumerGroupOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200, 204,
+			},
+		}
+		updateKafkaConsumerGroupOpt.JSONBody = map[string]interface{}{
+			"group_name": name,
+			"group_desc": d.Get("description"),
+		}
+		_, err = updateKafkaConsumerGroupClient.Request("PUT", updateKafkaConsumerGroupPath,
+			&updateKafkaConsumerGroupOpt)
+		if err != nil {
+			return diag.Errorf("error updating DMS kafka consumer group: %s", err)
+		}
+	}
+
+	return resourceDmsKafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func parseKafkaConsumerGroupID(id string) (instanceID, name string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		err = fmt.Errorf("invalid id format, must be <instance_id>/<consumerGroup>")
+		return
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceDmsKafkaConsumerGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getKafkaConsumerGroup: query DMS kafka consumer group
+	var (
+		getKafkaConsumerGroupHttpUrl = "v2/{project_id}/instances/{instance_id}/groups?group={group}"
+		getKafkaConsumerGroupProduct = "dmsv2"
+	)
+	getKafkaConsumerGroupClient, err := cfg.NewServiceClient(getKafkaConsumerGroupProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID, name, err := parseKafkaConsumerGroupID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	getKafkaConsumerGroupPath := getKafkaConsumerGroupClient.Endpoint + getKafkaConsumerGroupHttpUrl
+	getKafkaConsumerGroupPath = strings.ReplaceAll(getKafkaConsumerGroupPath, "{project_id}",
+		getKafkaConsumerGroupClient.ProjectID)
+	getKafkaConsumerGroupPath = strings.ReplaceAll(getKafkaConsumerGroupPath, "{instance_id}", instanceID)
+	getKafkaConsumerGroupPath = strings.ReplaceAll(getKafkaConsumerGroupPath, "{group}", name)
+
+	getKafkaConsumerGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getKafkaConsumerGroupResp, err := getKafkaConsumerGroupClient.Request("GET", getKafkaConsumerGroupPath,
+		&getKafkaConsumerGroupOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS kafka consumer group")
+	}
+
+	getKafkaConsumerGroupRespBody, err := utils.FlattenResponse(getKafkaConsumerGroupResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the query parameter group is a fuzzy match, so the consumer group is filtered by the exact name
+	group := utils.PathSearch(fmt.Sprintf("groups[?group_id=='%s']|[0]", name), getKafkaConsumerGroupRespBody, nil)
+	if group == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS kafka consumer group")
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("instance_id", instanceID),
+		d.Set("name", name),
+		d.Set("description", utils.PathSearch("group_desc", group, nil)),
+		d.Set("state", utils.PathSearch("state", group, nil)),
+		d.Set("coordinator_id", utils.PathSearch("coordinator_id", group, nil)),
+		d.Set("lag", utils.PathSearch("lag", group, nil)),
+		d.Set("created_at", utils.PathSearch("createdAt", group, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsKafkaConsumerGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteKafkaConsumerGroup: delete DMS kafka consumer group
+	var (
+		deleteKafkaConsumerGroupHttpUrl = "v2/{project_id}/instances/{instance_id}/groups/batch-delete"
+		deleteKafkaConsumerGroupProduct = "dmsv2"
+	)
+	deleteKafkaConsumerGroupClient, err := cfg.NewServiceClient(deleteKafkaConsumerGroupProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID, name, err := parseKafkaConsumerGroupID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	deleteKafkaConsumerGroupPath := deleteKafkaConsumerGroupClient.Endpoint + deleteKafkaConsumerGroupHttpUrl
+	deleteKafkaConsumerGroupPath = strings.ReplaceAll(deleteKafkaConsumerGroupPath, "{project_id}",
+		deleteKafkaConsumerGroupClient.ProjectID)
+	deleteKafkaConsumerGroupPath = strings.ReplaceAll(deleteKafkaConsumerGroupPath, "{instance_id}", instanceID)
+
+	deleteKafkaConsumerGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+		JSONBody: map[string]interface{}{
+			"group_ids": []string{name},
+		},
+	}
+	deleteKafkaConsumerGroupResp, err := deleteKafkaConsumerGroupClient.Request("POST", deleteKafkaConsumerGroupPath,
+		&deleteKafkaConsumerGroupOpt)
+	if err != nil {
+		return diag.Errorf("error deleting DMS kafka consumer group: %s", err)
+	}
+
+	// the batch deletion returns the failed groups in the response body
+	deleteKafkaConsumerGroupRespBody, err := utils.FlattenResponse(deleteKafkaConsumerGroupResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if failedGroup := utils.PathSearch("failed_groups|[0]", deleteKafkaConsumerGroupRespBody, nil); failedGroup != nil {
+		return diag.Errorf("error deleting DMS kafka consumer group: %v",
+			utils.PathSearch("error_message", failedGroup, nil))
+	}
+
+	return nil
+}
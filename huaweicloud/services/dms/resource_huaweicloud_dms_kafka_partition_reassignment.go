@@ -0,0 +1,268 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaPartitionReassignment is a one-time action resource, which reassigns the partitions of the topics
+// and waits for the reassignment task to be completed.
+func ResourceDmsKafkaPartitionReassignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaPartitionReassignmentCreate,
+		ReadContext:   resourceDmsKafkaPartitionReassignmentRead,
+		DeleteContext: resourceDmsKafkaPartitionReassignmentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the kafka instance.`,
+			},
+			"reassignments": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Specifies the name of the topic.`,
+						},
+						"brokers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: `Specifies the brokers to which the partitions are automatically reassigned.`,
+						},
+						"replication_factor": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `Specifies the replication factor used by the automatic assignment.`,
+						},
+						"assignment": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"partition": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										ForceNew:    true,
+										Description: `Specifies the partition number.`,
+									},
+									"partition_brokers": {
+										Type:        schema.TypeList,
+										Required:    true,
+										ForceNew:    true,
+										Elem:        &schema.Schema{Type: schema.TypeInt},
+										Description: `Specifies the brokers of the replicas, the first one is the leader.`,
+									},
+								},
+							},
+							Description: `Specifies the manual assignment of the partitions.`,
+						},
+					},
+				},
+				Description: `Specifies the reassignment plans of the topics.`,
+			},
+			"throttle": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the bandwidth limit of the replication, in byte/s.`,
+			},
+			"is_schedule": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"execute_at"},
+				Description:  `Specifies whether the reassignment is a scheduled task.`,
+			},
+			"execute_at": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the schedule time of the reassignment, a unix timestamp in milliseconds.`,
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the ID of the reassignment task.`,
+			},
+		},
+	}
+}
+
+func resourceDmsKafkaPartitionReassignmentCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createReassignment: reassign the partitions of the kafka topics
+	var (
+		createReassignmentHttpUrl = "v2/kafka/{project_id}/instances/{instance_id}/reassign"
+		createReassignmentProduct = "dmsv2"
+	)
+	createReassignmentClient, err := cfg.NewServiceClient(createReassignmentProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createReassignmentPath := createReassignmentClient.Endpoint + createReassignmentHttpUrl
+	createReassignmentPath = strings.ReplaceAll(createReassignmentPath, "{project_id}",
+		createReassignmentClient.ProjectID)
+	createReassignmentPath = strings.ReplaceAll(createReassignmentPath, "{instance_id}", instanceID)
+
+	createReassignmentOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	createReassignmentOpt.JSONBody = utils.RemoveNil(buildCreateKafkaPartitionReassignmentBodyParams(d))
+	createReassignmentResp, err := createReassignmentClient.Request("POST", createReassignmentPath,
+		&createReassignmentOpt)
+	if err != nil {
+		return diag.Errorf("error reassigning the partitions of the DMS kafka instance (%s): %s", instanceID, err)
+	}
+
+	createReassignmentRespBody, err := utils.FlattenResponse(createReassignmentResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	taskID := utils.PathSearch("job_id", createReassignmentRespBody, "").(string)
+	if taskID == "" {
+		return diag.Errorf("error reassigning the partitions: job_id is not found in API response")
+	}
+	d.SetId(taskID)
+
+	// the scheduled task is not executed until the schedule time, so there is nothing to wait for
+	if !d.Get("is_schedule").(bool) {
+		err = waitForKafkaTaskComplete(ctx, createReassignmentClient, instanceID, taskID,
+			d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error waiting for the partition reassignment task (%s) to complete: %s", taskID, err)
+		}
+	}
+
+	return resourceDmsKafkaPartitionReassignmentRead(ctx, d, meta)
+}
+
+func buildCreateKafkaPartitionReassignmentBodyParams(d *schema.ResourceData) map[string]interface{} {
+	rawReassignments := d.Get("reassignments").([]interface{})
+	reassignments := make([]map[string]interface{}, len(rawReassignments))
+	for i, v := range rawReassignments {
+		raw := v.(map[string]interface{})
+		rawAssignment := raw["assignment"].([]interface{})
+		assignment := make([]map[string]interface{}, len(rawAssignment))
+		for j, a := range rawAssignment {
+			rawPartition := a.(map[string]interface{})
+			assignment[j] = map[string]interface{}{
+				"partition":         rawPartition["partition"],
+				"partition_brokers": rawPartition["partition_brokers"],
+			}
+		}
+		reassignments[i] = map[string]interface{}{
+			"topic":              raw["topic"],
+			"brokers":            utils.ValueIngoreEmpty(raw["brokers"]),
+			"replication_factor": utils.ValueIngoreEmpty(raw["replication_factor"]),
+			"assignment":         utils.ValueIngoreEmpty(assignment),
+		}
+	}
+
+	bodyParams := map[string]interface{}{
+		"reassignments": reassignments,
+		"throttle":      utils.ValueIngoreEmpty(d.Get("throttle")),
+		"is_schedule":   utils.ValueIngoreEmpty(d.Get("is_schedule")),
+		"execute_at":    utils.ValueIngoreEmpty(d.Get("execute_at")),
+	}
+	return bodyParams
+}
+
+func waitForKafkaTaskComplete(ctx context.Context, client *golangsdk.ServiceClient, instanceID, taskID string,
+	timeout time.Duration) error {
+	getTaskPath := client.Endpoint + "v2/{project_id}/instances/{instance_id}/tasks/{task_id}"
+	getTaskPath = strings.ReplaceAll(getTaskPath, "{project_id}", client.ProjectID)
+	getTaskPath = strings.ReplaceAll(getTaskPath, "{instance_id}", instanceID)
+	getTaskPath = strings.ReplaceAll(getTaskPath, "{task_id}", taskID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATED", "EXECUTING"},
+		Target:  []string{"SUCCESS"},
+		Refresh: func() (interface{}, string, error) {
+			getTaskOpt := golangsdk.RequestOpts{
+				KeepResponseBody: true,
+				OkCodes: []int{
+					200,
+				},
+			}
+			getTaskResp, err := client.Request("GET", getTaskPath, &getTaskOpt)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			getTaskRespBody, err := utils.FlattenResponse(getTaskResp)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("tasks|[0].status", getTaskRespBody, "").(string)
+			if status == "FAILED" {
+				return getTaskRespBody, status, fmt.Errorf("the task is failed")
+			}
+			return getTaskRespBody, status, nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDmsKafkaPartitionReassignmentRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	// the reassignment is a one-time action, there is nothing to refresh from the API
+	mErr := multierror.Append(nil,
+		d.Set("region", cfg.GetRegion(d)),
+		d.Set("task_id", d.Id()),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsKafkaPartitionReassignmentDelete(_ context.Context, d *schema.ResourceData,
+	_ interface{}) diag.Diagnostics {
+	log.Printf("[WARN] deleting the partition reassignment resource (%s) only removes it from the state", d.Id())
+	return nil
+}
@@ -0,0 +1,254 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dms/v2/kafka/instances"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaSmartConnect is the impl of huaweicloud_dms_kafka_smart_connect resource, which enables the Smart
+// Connect of the kafka instance. The resource ID is the connector ID.
+func ResourceDmsKafkaSmartConnect() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaSmartConnectCreate,
+		ReadContext:   resourceDmsKafkaSmartConnectRead,
+		DeleteContext: resourceDmsKafkaSmartConnectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsKafkaSmartConnectImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the kafka instance.`,
+			},
+			"storage_spec_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the specification code of the connector nodes.`,
+			},
+			"bandwidth": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"100MB", "300MB", "600MB", "1200MB"}, false),
+				Description:  `Specifies the bandwidth of the connector.`,
+			},
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(2),
+				Description:  `Specifies the number of the connector nodes.`,
+			},
+		},
+	}
+}
+
+func resourceDmsKafkaSmartConnectCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createSmartConnect: enable the Smart Connect of the kafka instance
+	var (
+		createSmartConnectHttpUrl = "v2/{project_id}/instances/{instance_id}/connector"
+		createSmartConnectProduct = "dmsv2"
+	)
+	createSmartConnectClient, err := cfg.NewServiceClient(createSmartConnectProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createSmartConnectPath := createSmartConnectClient.Endpoint + createSmartConnectHttpUrl
+	createSmartConnectPath = strings.ReplaceAll(createSmartConnectPath, "{project_id}",
+		createSmartConnectClient.ProjectID)
+	createSmartConnectPath = strings.ReplaceAll(createSmartConnectPath, "{instance_id}", instanceID)
+
+	createSmartConnectOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	createSmartConnectOpt.JSONBody = utils.RemoveNil(buildCreateKafkaSmartConnectBodyParams(d))
+	createSmartConnectResp, err := createSmartConnectClient.Request("POST", createSmartConnectPath,
+		&createSmartConnectOpt)
+	if err != nil {
+		return diag.Errorf("error enabling the Smart Connect of the DMS kafka instance (%s): %s", instanceID, err)
+	}
+
+	createSmartConnectRespBody, err := utils.FlattenResponse(createSmartConnectResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	connectorID := utils.PathSearch("connector_id", createSmartConnectRespBody, "").(string)
+	if connectorID == "" {
+		return diag.Errorf("error enabling the Smart Connect: connector_id is not found in API response")
+	}
+	d.SetId(connectorID)
+
+	if err = waitForKafkaSmartConnectStatus(ctx, createSmartConnectClient, instanceID, true,
+		d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the Smart Connect of the DMS kafka instance (%s) to be enabled: %s",
+			instanceID, err)
+	}
+
+	return resourceDmsKafkaSmartConnectRead(ctx, d, meta)
+}
+
+func buildCreateKafkaSmartConnectBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"specification": utils.ValueIngoreEmpty(d.Get("bandwidth")),
+		"spec_code":     utils.ValueIngoreEmpty(d.Get("storage_spec_code")),
+	}
+	if v, ok := d.GetOk("node_count"); ok {
+		bodyParams["node_cnt"] = strconv.Itoa(v.(int))
+	}
+	return bodyParams
+}
+
+// waitForKafkaSmartConnectStatus waits for the Smart Connect of the instance to be enabled or disabled and the
+// instance to be running.
+func waitForKafkaSmartConnectStatus(ctx context.Context, client *golangsdk.ServiceClient, instanceID string,
+	enabled bool, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			instance, err := instances.Get(client, instanceID).Extract()
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			if instance.ConnectorEnalbe == enabled && instance.Status == "RUNNING" {
+				return instance, "COMPLETED", nil
+			}
+			return instance, "PENDING", nil
+		},
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDmsKafkaSmartConnectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instance, err := instances.Get(client, d.Get("instance_id").(string)).Extract()
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS kafka instance")
+	}
+	if !instance.ConnectorEnalbe || instance.ConnectorID != d.Id() {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS kafka Smart Connect")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsKafkaSmartConnectDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteSmartConnect: disable the Smart Connect of the kafka instance
+	var (
+		deleteSmartConnectHttpUrl = "v2/{project_id}/kafka/instances/{instance_id}/delete-connector"
+		deleteSmartConnectProduct = "dmsv2"
+	)
+	deleteSmartConnectClient, err := cfg.NewServiceClient(deleteSmartConnectProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	deleteSmartConnectPath := deleteSmartConnectClient.Endpoint + deleteSmartConnectHttpUrl
+	deleteSmartConnectPath = strings.ReplaceAll(deleteSmartConnectPath, "{project_id}",
+		deleteSmartConnectClient.ProjectID)
+	deleteSmartConnectPath = strings.ReplaceAll(deleteSmartConnectPath, "{instance_id}", instanceID)
+
+	deleteSmartConnectOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+		JSONBody: map[string]interface{}{},
+	}
+	_, err = deleteSmartConnectClient.Request("POST", deleteSmartConnectPath, &deleteSmartConnectOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling the Smart Connect of the DMS kafka instance")
+	}
+
+	if err = waitForKafkaSmartConnectStatus(ctx, deleteSmartConnectClient, instanceID, false,
+		d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for the Smart Connect of the DMS kafka instance (%s) to be disabled: %s",
+			instanceID, err)
+	}
+
+	return nil
+}
+
+// resourceDmsKafkaSmartConnectImportState imports the Smart Connect by the instance ID, the connector ID is
+// obtained from the instance.
+func resourceDmsKafkaSmartConnectImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DmsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return nil, err
+	}
+
+	instanceID := d.Id()
+	instance, err := instances.Get(client, instanceID).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if !instance.ConnectorEnalbe {
+		return nil, fmt.Errorf("the Smart Connect of the DMS kafka instance (%s) is not enabled", instanceID)
+	}
+
+	d.SetId(instance.ConnectorID)
+	return []*schema.ResourceData{d}, d.Set("instance_id", instanceID)
+}
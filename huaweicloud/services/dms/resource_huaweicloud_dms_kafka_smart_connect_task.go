@@ -0,0 +1,604 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaSmartConnectTask is the impl of huaweicloud_dms_kafka_smart_connect_task resource, which manages the
+// Kafka-to-Kafka replication and the Kafka-to-OBS dumping tasks of the Smart Connect.
+func ResourceDmsKafkaSmartConnectTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaSmartConnectTaskCreate,
+		ReadContext:   resourceDmsKafkaSmartConnectTaskRead,
+		DeleteContext: resourceDmsKafkaSmartConnectTaskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsKafkaSmartConnectTaskImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the kafka instance.`,
+			},
+			"task_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the Smart Connect task.`,
+			},
+			"start_later": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to start the task later.`,
+			},
+			"topics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"topics", "topics_regex"},
+				Description:  `Specifies the topic names of the task.`,
+			},
+			"topics_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the regular expression of the topic names of the task.`,
+			},
+			"source_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"KAFKA_REPLICATOR_SOURCE"}, false),
+				RequiredWith: []string{"source_task"},
+				Description:  `Specifies the source type of the task.`,
+			},
+			"source_task": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     smartConnectSourceTaskSchema(),
+			},
+			"destination_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OBS_SINK"}, false),
+				RequiredWith: []string{"destination_task"},
+				AtLeastOneOf: []string{"source_type", "destination_type"},
+				Description:  `Specifies the destination type of the task.`,
+			},
+			"destination_task": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     smartConnectDestinationTaskSchema(),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the task.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the task is created.`,
+			},
+		},
+	}
+}
+
+func smartConnectSourceTaskSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"peer_instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the peer kafka instance.`,
+			},
+			"peer_instance_address": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the addresses of the peer kafka instance.`,
+			},
+			"current_instance_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the alias of the current kafka instance.`,
+			},
+			"peer_instance_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the alias of the peer kafka instance.`,
+			},
+			"security_protocol": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the security protocol of the peer kafka instance.`,
+			},
+			"sasl_mechanism": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the SASL mechanism of the peer kafka instance.`,
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the user name of the peer kafka instance.`,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: `Specifies the password of the peer kafka instance.`,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pull", "push", "two-way"}, false),
+				Description:  `Specifies the replication direction.`,
+			},
+			"sync_consumer_offsets_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to synchronize the consumer offsets.`,
+			},
+			"replication_factor": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the number of the replicas of the topics created in the peer instance.`,
+			},
+			"task_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the number of the data replication tasks.`,
+			},
+			"rename_topic_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to add the alias prefix to the replicated topic names.`,
+			},
+			"provenance_header_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to add the provenance header to the replicated messages.`,
+			},
+			"consumer_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"latest", "earliest"}, false),
+				Description:  `Specifies the start offset of the replication.`,
+			},
+			"compression_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the compression type of the replicated messages.`,
+			},
+			"topics_mapping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the topic mappings, in the format of source_topic:target_topic.`,
+			},
+		},
+	}
+}
+
+func smartConnectDestinationTaskSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"obs_bucket_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the OBS bucket.`,
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: `Specifies the access key used to access the OBS bucket.`,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: `Specifies the secret key used to access the OBS bucket.`,
+			},
+			"consumer_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"latest", "earliest"}, false),
+				Description:  `Specifies the start offset of the dumping.`,
+			},
+			"destination_file_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "TEXT",
+				Description: `Specifies the type of the dumped files.`,
+			},
+			"deliver_time_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the dumping period, in seconds.`,
+			},
+			"obs_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the directory of the dumped files in the OBS bucket.`,
+			},
+			"partition_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the time directory format of the dumped files.`,
+			},
+			"record_delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the delimiter of the dumped records.`,
+			},
+			"store_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to dump the message keys.`,
+			},
+		},
+	}
+}
+
+func buildDmsKafkaSmartConnectTaskPath(client *golangsdk.ServiceClient, instanceID, taskID string) string {
+	path := client.Endpoint + "v2/{project_id}/instances/{instance_id}/connector/tasks"
+	if taskID != "" {
+		path += "/" + taskID
+	}
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceID)
+}
+
+func resourceDmsKafkaSmartConnectTaskCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createSmartConnectTask: create the Smart Connect task of the kafka instance
+	createSmartConnectTaskProduct := "dmsv2"
+	createSmartConnectTaskClient, err := cfg.NewServiceClient(createSmartConnectTaskProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	createSmartConnectTaskOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	createSmartConnectTaskOpt.JSONBody = utils.RemoveNil(buildCreateKafkaSmartConnectTaskBodyParams(d))
+	createSmartConnectTaskResp, err := createSmartConnectTaskClient.Request("POST",
+		buildDmsKafkaSmartConnectTaskPath(createSmartConnectTaskClient, instanceID, ""), &createSmartConnectTaskOpt)
+	if err != nil {
+		return diag.Errorf("error creating DMS kafka Smart Connect task: %s", err)
+	}
+
+	createSmartConnectTaskRespBody, err := utils.FlattenResponse(createSmartConnectTaskResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := utils.PathSearch("id", createSmartConnectTaskRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating DMS kafka Smart Connect task: id is not found in API response")
+	}
+	d.SetId(id)
+
+	target := "RUNNING"
+	if d.Get("start_later").(bool) {
+		target = "WAITING"
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING"},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			task, err := getDmsKafkaSmartConnectTask(createSmartConnectTaskClient, instanceID, id)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("status", task, "").(string)
+			if status == "ERROR" {
+				return task, status, fmt.Errorf("the task is abnormal")
+			}
+			return task, status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the DMS kafka Smart Connect task (%s) to be %s: %s", id, target, err)
+	}
+
+	return resourceDmsKafkaSmartConnectTaskRead(ctx, d, meta)
+}
+
+func buildCreateKafkaSmartConnectTaskBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"task_name":    d.Get("task_name"),
+		"start_later":  d.Get("start_later"),
+		"topics":       utils.ValueIngoreEmpty(strings.Join(utils.ExpandToStringList(d.Get("topics").([]interface{})), ",")),
+		"topics_regex": utils.ValueIngoreEmpty(d.Get("topics_regex")),
+		"source_type":  "NONE",
+		"sink_type":    "NONE",
+	}
+	if v, ok := d.GetOk("source_type"); ok {
+		bodyParams["source_type"] = v
+		bodyParams["source_task"] = buildKafkaSmartConnectSourceTaskBodyParams(d.Get("source_task").([]interface{}))
+	}
+	if v, ok := d.GetOk("destination_type"); ok {
+		bodyParams["sink_type"] = v
+		bodyParams["sink_task"] = buildKafkaSmartConnectSinkTaskBodyParams(d.Get("destination_task").([]interface{}))
+	}
+	return bodyParams
+}
+
+func buildKafkaSmartConnectSourceTaskBodyParams(rawParams []interface{}) map[string]interface{} {
+	if len(rawParams) == 0 {
+		return nil
+	}
+	raw := rawParams[0].(map[string]interface{})
+	return map[string]interface{}{
+		"current_cluster_name": utils.ValueIngoreEmpty(raw["current_instance_alias"]),
+		"cluster_name":         utils.ValueIngoreEmpty(raw["peer_instance_alias"]),
+		"instance_id":          utils.ValueIngoreEmpty(raw["peer_instance_id"]),
+		"bootstrap_servers": utils.ValueIngoreEmpty(strings.Join(
+			utils.ExpandToStringList(raw["peer_instance_address"].([]interface{})), ",")),
+		"security_protocol":             utils.ValueIngoreEmpty(raw["security_protocol"]),
+		"sasl_mechanism":                utils.ValueIngoreEmpty(raw["sasl_mechanism"]),
+		"user_name":                     utils.ValueIngoreEmpty(raw["user_name"]),
+		"password":                      utils.ValueIngoreEmpty(raw["password"]),
+		"direction":                     utils.ValueIngoreEmpty(raw["direction"]),
+		"sync_consumer_offsets_enabled": raw["sync_consumer_offsets_enabled"],
+		"replication_factor":            utils.ValueIngoreEmpty(raw["replication_factor"]),
+		"task_num":                      utils.ValueIngoreEmpty(raw["task_num"]),
+		"rename_topic_enabled":          raw["rename_topic_enabled"],
+		"provenance_header_enabled":     raw["provenance_header_enabled"],
+		"consumer_strategy":             utils.ValueIngoreEmpty(raw["consumer_strategy"]),
+		"compression_type":              utils.ValueIngoreEmpty(raw["compression_type"]),
+		"topics_mapping": utils.ValueIngoreEmpty(strings.Join(
+			utils.ExpandToStringList(raw["topics_mapping"].([]interface{})), ",")),
+	}
+}
+
+func buildKafkaSmartConnectSinkTaskBodyParams(rawParams []interface{}) map[string]interface{} {
+	if len(rawParams) == 0 {
+		return nil
+	}
+	raw := rawParams[0].(map[string]interface{})
+	return map[string]interface{}{
+		"obs_bucket_name":       raw["obs_bucket_name"],
+		"access_key":            raw["access_key"],
+		"secret_key":            raw["secret_key"],
+		"consumer_strategy":     utils.ValueIngoreEmpty(raw["consumer_strategy"]),
+		"destination_file_type": utils.ValueIngoreEmpty(raw["destination_file_type"]),
+		"deliver_time_interval": utils.ValueIngoreEmpty(raw["deliver_time_interval"]),
+		"obs_path":              utils.ValueIngoreEmpty(raw["obs_path"]),
+		"partition_format":      utils.ValueIngoreEmpty(raw["partition_format"]),
+		"record_delimiter":      utils.ValueIngoreEmpty(raw["record_delimiter"]),
+		"store_keys":            raw["store_keys"],
+	}
+}
+
+func getDmsKafkaSmartConnectTask(client *golangsdk.ServiceClient, instanceID, taskID string) (interface{}, error) {
+	getSmartConnectTaskOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getSmartConnectTaskResp, err := client.Request("GET",
+		buildDmsKafkaSmartConnectTaskPath(client, instanceID, taskID), &getSmartConnectTaskOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getSmartConnectTaskResp)
+}
+
+func resourceDmsKafkaSmartConnectTaskRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getSmartConnectTask: query the Smart Connect task of the kafka instance
+	getSmartConnectTaskProduct := "dmsv2"
+	getSmartConnectTaskClient, err := cfg.NewServiceClient(getSmartConnectTaskProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	task, err := getDmsKafkaSmartConnectTask(getSmartConnectTaskClient, d.Get("instance_id").(string), d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS kafka Smart Connect task")
+	}
+
+	var createdAt string
+	if v, ok := utils.PathSearch("create_time", task, nil).(float64); ok {
+		createdAt = utils.FormatTimeStampRFC3339(int64(v)/1000, false)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("task_name", utils.PathSearch("task_name", task, nil)),
+		d.Set("topics", splitKafkaSmartConnectList(utils.PathSearch("topics", task, nil))),
+		d.Set("topics_regex", utils.PathSearch("topics_regex", task, nil)),
+		d.Set("status", utils.PathSearch("status", task, nil)),
+		d.Set("created_at", createdAt),
+	)
+	if v := utils.PathSearch("source_type", task, "").(string); v != "" && v != "NONE" {
+		mErr = multierror.Append(mErr,
+			d.Set("source_type", v),
+			d.Set("source_task", flattenKafkaSmartConnectSourceTask(d, utils.PathSearch("source_task", task, nil))),
+		)
+	}
+	if v := utils.PathSearch("sink_type", task, "").(string); v != "" && v != "NONE" {
+		mErr = multierror.Append(mErr,
+			d.Set("destination_type", v),
+			d.Set("destination_task", flattenKafkaSmartConnectSinkTask(d, utils.PathSearch("sink_task", task, nil))),
+		)
+	}
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func splitKafkaSmartConnectList(v interface{}) []string {
+	if s, ok := v.(string); ok && s != "" {
+		return strings.Split(s, ",")
+	}
+	return nil
+}
+
+// flattenKafkaSmartConnectSourceTask flattens the source task, the password is not returned by the API, so it is
+// kept from the state.
+func flattenKafkaSmartConnectSourceTask(d *schema.ResourceData, sourceTask interface{}) []map[string]interface{} {
+	if sourceTask == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"peer_instance_id":              utils.PathSearch("instance_id", sourceTask, nil),
+			"peer_instance_address":         splitKafkaSmartConnectList(utils.PathSearch("bootstrap_servers", sourceTask, nil)),
+			"current_instance_alias":        utils.PathSearch("current_cluster_name", sourceTask, nil),
+			"peer_instance_alias":           utils.PathSearch("cluster_name", sourceTask, nil),
+			"security_protocol":             utils.PathSearch("security_protocol", sourceTask, nil),
+			"sasl_mechanism":                utils.PathSearch("sasl_mechanism", sourceTask, nil),
+			"user_name":                     utils.PathSearch("user_name", sourceTask, nil),
+			"password":                      d.Get("source_task.0.password"),
+			"direction":                     utils.PathSearch("direction", sourceTask, nil),
+			"sync_consumer_offsets_enabled": utils.PathSearch("sync_consumer_offsets_enabled", sourceTask, nil),
+			"replication_factor":            utils.PathSearch("replication_factor", sourceTask, nil),
+			"task_num":                      utils.PathSearch("task_num", sourceTask, nil),
+			"rename_topic_enabled":          utils.PathSearch("rename_topic_enabled", sourceTask, nil),
+			"provenance_header_enabled":     utils.PathSearch("provenance_header_enabled", sourceTask, nil),
+			"consumer_strategy":             utils.PathSearch("consumer_strategy", sourceTask, nil),
+			"compression_type":              utils.PathSearch("compression_type", sourceTask, nil),
+			"topics_mapping":                splitKafkaSmartConnectList(utils.PathSearch("topics_mapping", sourceTask, nil)),
+		},
+	}
+}
+
+// flattenKafkaSmartConnectSinkTask flattens the sink task, the access key and the secret key are not returned by the
+// API, so they are kept from the state.
+func flattenKafkaSmartConnectSinkTask(d *schema.ResourceData, sinkTask interface{}) []map[string]interface{} {
+	if sinkTask == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"obs_bucket_name":       utils.PathSearch("obs_bucket_name", sinkTask, nil),
+			"access_key":            d.Get("destination_task.0.access_key"),
+			"secret_key":            d.Get("destination_task.0.secret_key"),
+			"consumer_strategy":     utils.PathSearch("consumer_strategy", sinkTask, nil),
+			"destination_file_type": utils.PathSearch("destination_file_type", sinkTask, nil),
+			"deliver_time_interval": utils.PathSearch("deliver_time_interval", sinkTask, nil),
+			"obs_path":              utils.PathSearch("obs_path", sinkTask, nil),
+			"partition_format":      utils.PathSearch("partition_format", sinkTask, nil),
+			"record_delimiter":      utils.PathSearch("record_delimiter", sinkTask, nil),
+			"store_keys":            utils.PathSearch("store_keys", sinkTask, nil),
+		},
+	}
+}
+
+func resourceDmsKafkaSmartConnectTaskDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteSmartConnectTask: delete the Smart Connect task of the kafka instance
+	deleteSmartConnectTaskProduct := "dmsv2"
+	deleteSmartConnectTaskClient, err := cfg.NewServiceClient(deleteSmartConnectTaskProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	deleteSmartConnectTaskOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+	}
+	_, err = deleteSmartConnectTaskClient.Request("DELETE",
+		buildDmsKafkaSmartConnectTaskPath(deleteSmartConnectTaskClient, d.Get("instance_id").(string), d.Id()),
+		&deleteSmartConnectTaskOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS kafka Smart Connect task")
+	}
+
+	return nil
+}
+
+func resourceDmsKafkaSmartConnectTaskImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<task_id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
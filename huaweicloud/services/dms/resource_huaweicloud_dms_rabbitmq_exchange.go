@@ -0,0 +1,201 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsRabbitmqExchange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqExchangeCreate,
+		ReadContext:   resourceDmsRabbitmqExchangeRead,
+		DeleteContext: resourceDmsRabbitmqExchangeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitmqExchangeImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the vhost to which the exchange belongs.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the exchange.`,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"direct", "fanout", "topic", "headers", "x-delayed-message", "x-consistent-hash",
+				}, false),
+				Description: `Specifies the type of the exchange.`,
+			},
+			"auto_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to delete the exchange automatically when it is no longer used.`,
+			},
+			"durable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: `Specifies whether the exchange survives a broker restart.`,
+			},
+			"internal": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether the exchange is internal.`,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitmqExchangeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createRabbitmqExchange: create DMS RabbitMQ exchange
+	var (
+		createRabbitmqExchangeHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges"
+		createRabbitmqExchangeProduct = "dmsv2"
+	)
+	createRabbitmqExchangeClient, err := cfg.NewServiceClient(createRabbitmqExchangeProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	name := d.Get("name").(string)
+	createRabbitmqExchangeOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201,
+		},
+		JSONBody: map[string]interface{}{
+			"name":        name,
+			"type":        d.Get("type"),
+			"auto_delete": d.Get("auto_delete"),
+			"durable":     d.Get("durable"),
+			"internal":    d.Get("internal"),
+		},
+	}
+	_, err = createRabbitmqExchangeClient.Request("POST",
+		buildRabbitmqManagementPath(createRabbitmqExchangeClient, createRabbitmqExchangeHttpUrl, instanceID, vhost),
+		&createRabbitmqExchangeOpt)
+	if err != nil {
+		return diag.Errorf("error creating DMS RabbitMQ exchange: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, vhost, name))
+
+	return resourceDmsRabbitmqExchangeRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitmqExchangeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getRabbitmqExchange: query DMS RabbitMQ exchange
+	var (
+		getRabbitmqExchangeHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges"
+		getRabbitmqExchangeProduct = "dmsv2"
+	)
+	getRabbitmqExchangeClient, err := cfg.NewServiceClient(getRabbitmqExchangeProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	exchanges, err := listRabbitmqManagementItems(getRabbitmqExchangeClient,
+		buildRabbitmqManagementPath(getRabbitmqExchangeClient, getRabbitmqExchangeHttpUrl,
+			d.Get("instance_id").(string), d.Get("vhost").(string)))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS RabbitMQ exchange")
+	}
+
+	exchange := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", d.Get("name")), exchanges, nil)
+	if exchange == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS RabbitMQ exchange")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("type", utils.PathSearch("type", exchange, nil)),
+		d.Set("auto_delete", utils.PathSearch("auto_delete", exchange, nil)),
+		d.Set("durable", utils.PathSearch("durable", exchange, nil)),
+		d.Set("internal", utils.PathSearch("internal", exchange, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsRabbitmqExchangeDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteRabbitmqExchange: delete DMS RabbitMQ exchange
+	var (
+		deleteRabbitmqExchangeHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/" +
+			"exchanges/batch-delete"
+		deleteRabbitmqExchangeProduct = "dmsv2"
+	)
+	deleteRabbitmqExchangeClient, err := cfg.NewServiceClient(deleteRabbitmqExchangeProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	err = deleteRabbitmqManagementItem(deleteRabbitmqExchangeClient,
+		buildRabbitmqManagementPath(deleteRabbitmqExchangeClient, deleteRabbitmqExchangeHttpUrl,
+			d.Get("instance_id").(string), d.Get("vhost").(string)), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS RabbitMQ exchange")
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitmqExchangeImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, vhost, suffixes, err := parseRabbitmqResourceID(d.Id(), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", instanceID),
+		d.Set("vhost", vhost),
+		d.Set("name", suffixes[0]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,234 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRabbitmqExchangeQueueBinding is the impl of huaweicloud_dms_rabbitmq_exchange_queue_binding resource,
+// the ID is formatted <instance_id>/<vhost>/<exchange>/<destination_type>/<destination>/<routing_key>.
+func ResourceDmsRabbitmqExchangeQueueBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqExchangeQueueBindingCreate,
+		ReadContext:   resourceDmsRabbitmqExchangeQueueBindingRead,
+		DeleteContext: resourceDmsRabbitmqExchangeQueueBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitmqExchangeQueueBindingImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the vhost to which the exchange belongs.`,
+			},
+			"exchange": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the source exchange.`,
+			},
+			"destination_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Queue",
+				ValidateFunc: validation.StringInSlice([]string{"Queue", "Exchange"}, false),
+				Description:  `Specifies the type of the binding destination.`,
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the destination queue or exchange.`,
+			},
+			"routing_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the routing key of the binding.`,
+			},
+			"properties_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the properties key of the binding, which identifies the binding.`,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitmqExchangeQueueBindingCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createRabbitmqBinding: bind the queue or exchange to the DMS RabbitMQ exchange
+	var (
+		createRabbitmqBindingHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/" +
+			"exchanges/{exchange}/binds"
+		createRabbitmqBindingProduct = "dmsv2"
+	)
+	createRabbitmqBindingClient, err := cfg.NewServiceClient(createRabbitmqBindingProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	exchange := d.Get("exchange").(string)
+	createRabbitmqBindingPath := buildRabbitmqManagementPath(createRabbitmqBindingClient, createRabbitmqBindingHttpUrl,
+		instanceID, vhost)
+	createRabbitmqBindingPath = strings.ReplaceAll(createRabbitmqBindingPath, "{exchange}", exchange)
+
+	createRabbitmqBindingOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201,
+		},
+		JSONBody: map[string]interface{}{
+			"destination_type": d.Get("destination_type"),
+			"destination":      d.Get("destination"),
+			"routing_key":      d.Get("routing_key"),
+		},
+	}
+	_, err = createRabbitmqBindingClient.Request("POST", createRabbitmqBindingPath, &createRabbitmqBindingOpt)
+	if err != nil {
+		return diag.Errorf("error creating DMS RabbitMQ binding: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s/%s", instanceID, vhost, exchange, d.Get("destination_type"),
+		d.Get("destination"), d.Get("routing_key")))
+
+	return resourceDmsRabbitmqExchangeQueueBindingRead(ctx, d, meta)
+}
+
+// getRabbitmqExchangeQueueBinding queries the binding by the destination and the routing key.
+func getRabbitmqExchangeQueueBinding(client *golangsdk.ServiceClient, d *schema.ResourceData) (interface{}, error) {
+	getRabbitmqBindingHttpUrl := "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/" +
+		"exchanges/{exchange}/binds"
+	getRabbitmqBindingPath := buildRabbitmqManagementPath(client, getRabbitmqBindingHttpUrl,
+		d.Get("instance_id").(string), d.Get("vhost").(string))
+	getRabbitmqBindingPath = strings.ReplaceAll(getRabbitmqBindingPath, "{exchange}", d.Get("exchange").(string))
+
+	bindings, err := listRabbitmqManagementItems(client, getRabbitmqBindingPath)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("[?destination_type=='%s'&&destination=='%s'&&routing_key=='%s']|[0]",
+		d.Get("destination_type"), d.Get("destination"), d.Get("routing_key"))
+	binding := utils.PathSearch(expression, bindings, nil)
+	if binding == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return binding, nil
+}
+
+func resourceDmsRabbitmqExchangeQueueBindingRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getRabbitmqBinding: query DMS RabbitMQ binding
+	getRabbitmqBindingProduct := "dmsv2"
+	getRabbitmqBindingClient, err := cfg.NewServiceClient(getRabbitmqBindingProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	binding, err := getRabbitmqExchangeQueueBinding(getRabbitmqBindingClient, d)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS RabbitMQ binding")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("properties_key", utils.PathSearch("properties_key", binding, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsRabbitmqExchangeQueueBindingDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteRabbitmqBinding: unbind the queue or exchange from the DMS RabbitMQ exchange
+	var (
+		deleteRabbitmqBindingHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/" +
+			"exchanges/{exchange}/destination-type/{destination_type}/destination/{destination}/" +
+			"properties-key/{properties_key}/unbind"
+		deleteRabbitmqBindingProduct = "dmsv2"
+	)
+	deleteRabbitmqBindingClient, err := cfg.NewServiceClient(deleteRabbitmqBindingProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	deleteRabbitmqBindingPath := buildRabbitmqManagementPath(deleteRabbitmqBindingClient, deleteRabbitmqBindingHttpUrl,
+		d.Get("instance_id").(string), d.Get("vhost").(string))
+	deleteRabbitmqBindingPath = strings.ReplaceAll(deleteRabbitmqBindingPath, "{exchange}",
+		d.Get("exchange").(string))
+	deleteRabbitmqBindingPath = strings.ReplaceAll(deleteRabbitmqBindingPath, "{destination_type}",
+		d.Get("destination_type").(string))
+	deleteRabbitmqBindingPath = strings.ReplaceAll(deleteRabbitmqBindingPath, "{destination}",
+		d.Get("destination").(string))
+	deleteRabbitmqBindingPath = strings.ReplaceAll(deleteRabbitmqBindingPath, "{properties_key}",
+		d.Get("properties_key").(string))
+
+	deleteRabbitmqBindingOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+	}
+	_, err = deleteRabbitmqBindingClient.Request("DELETE", deleteRabbitmqBindingPath, &deleteRabbitmqBindingOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS RabbitMQ binding")
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitmqExchangeQueueBindingImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, vhost, suffixes, err := parseRabbitmqResourceID(d.Id(), 4)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", instanceID),
+		d.Set("vhost", vhost),
+		d.Set("exchange", suffixes[0]),
+		d.Set("destination_type", suffixes[1]),
+		d.Set("destination", suffixes[2]),
+		d.Set("routing_key", suffixes[3]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,247 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsRabbitmqQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqQueueCreate,
+		ReadContext:   resourceDmsRabbitmqQueueRead,
+		DeleteContext: resourceDmsRabbitmqQueueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitmqQueueImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the vhost to which the queue belongs.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the queue.`,
+			},
+			"auto_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to delete the queue automatically when the last consumer unsubscribes.`,
+			},
+			"durable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: `Specifies whether the queue survives a broker restart.`,
+			},
+			"dead_letter_exchange": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the exchange to which the dead letters are republished.`,
+			},
+			"dead_letter_routing_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"dead_letter_exchange"},
+				Description:  `Specifies the routing key used when the dead letters are republished.`,
+			},
+			"message_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies how long a message can live in the queue, in milliseconds.`,
+			},
+			"lazy_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"lazy"}, false),
+				Description:  `Specifies whether the queue keeps the messages on the disk as early as possible.`,
+			},
+			"messages": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the number of the messages in the queue.`,
+			},
+			"consumers": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the number of the consumers of the queue.`,
+			},
+			"policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the policy applied to the queue.`,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitmqQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createRabbitmqQueue: create DMS RabbitMQ queue
+	var (
+		createRabbitmqQueueHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/queues"
+		createRabbitmqQueueProduct = "dmsv2"
+	)
+	createRabbitmqQueueClient, err := cfg.NewServiceClient(createRabbitmqQueueProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	createRabbitmqQueueOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201,
+		},
+	}
+	createRabbitmqQueueOpt.JSONBody = utils.RemoveNil(buildCreateRabbitmqQueueBodyParams(d))
+	_, err = createRabbitmqQueueClient.Request("POST",
+		buildRabbitmqManagementPath(createRabbitmqQueueClient, createRabbitmqQueueHttpUrl, instanceID, vhost),
+		&createRabbitmqQueueOpt)
+	if err != nil {
+		return diag.Errorf("error creating DMS RabbitMQ queue: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, vhost, d.Get("name")))
+
+	return resourceDmsRabbitmqQueueRead(ctx, d, meta)
+}
+
+func buildCreateRabbitmqQueueBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"name":                    d.Get("name"),
+		"auto_delete":             d.Get("auto_delete"),
+		"durable":                 d.Get("durable"),
+		"dead_letter_exchange":    utils.ValueIngoreEmpty(d.Get("dead_letter_exchange")),
+		"dead_letter_routing_key": utils.ValueIngoreEmpty(d.Get("dead_letter_routing_key")),
+		"message_ttl":             utils.ValueIngoreEmpty(d.Get("message_ttl")),
+		"lazy_mode":               utils.ValueIngoreEmpty(d.Get("lazy_mode")),
+	}
+	return bodyParams
+}
+
+func resourceDmsRabbitmqQueueRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getRabbitmqQueue: query DMS RabbitMQ queue
+	var (
+		getRabbitmqQueueHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/queues/{queue}"
+		getRabbitmqQueueProduct = "dmsv2"
+	)
+	getRabbitmqQueueClient, err := cfg.NewServiceClient(getRabbitmqQueueProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	getRabbitmqQueuePath := buildRabbitmqManagementPath(getRabbitmqQueueClient, getRabbitmqQueueHttpUrl,
+		d.Get("instance_id").(string), d.Get("vhost").(string))
+	getRabbitmqQueuePath = strings.ReplaceAll(getRabbitmqQueuePath, "{queue}", d.Get("name").(string))
+
+	getRabbitmqQueueOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getRabbitmqQueueResp, err := getRabbitmqQueueClient.Request("GET", getRabbitmqQueuePath, &getRabbitmqQueueOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS RabbitMQ queue")
+	}
+
+	queue, err := utils.FlattenResponse(getRabbitmqQueueResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("auto_delete", utils.PathSearch("auto_delete", queue, nil)),
+		d.Set("durable", utils.PathSearch("durable", queue, nil)),
+		d.Set("dead_letter_exchange", utils.PathSearch(`arguments."x-dead-letter-exchange"`, queue, nil)),
+		d.Set("dead_letter_routing_key", utils.PathSearch(`arguments."x-dead-letter-routing-key"`, queue, nil)),
+		d.Set("message_ttl", utils.PathSearch(`arguments."x-message-ttl"`, queue, nil)),
+		d.Set("lazy_mode", utils.PathSearch(`arguments."x-queue-mode"`, queue, nil)),
+		d.Set("messages", utils.PathSearch("messages", queue, nil)),
+		d.Set("consumers", utils.PathSearch("consumers", queue, nil)),
+		d.Set("policy", utils.PathSearch("policy", queue, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsRabbitmqQueueDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteRabbitmqQueue: delete DMS RabbitMQ queue
+	var (
+		deleteRabbitmqQueueHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/" +
+			"queues/batch-delete"
+		deleteRabbitmqQueueProduct = "dmsv2"
+	)
+	deleteRabbitmqQueueClient, err := cfg.NewServiceClient(deleteRabbitmqQueueProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	err = deleteRabbitmqManagementItem(deleteRabbitmqQueueClient,
+		buildRabbitmqManagementPath(deleteRabbitmqQueueClient, deleteRabbitmqQueueHttpUrl,
+			d.Get("instance_id").(string), d.Get("vhost").(string)), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS RabbitMQ queue")
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitmqQueueImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	instanceID, vhost, suffixes, err := parseRabbitmqResourceID(d.Id(), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", instanceID),
+		d.Set("vhost", vhost),
+		d.Set("name", suffixes[0]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
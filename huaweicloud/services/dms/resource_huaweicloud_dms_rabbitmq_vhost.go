@@ -0,0 +1,240 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsRabbitmqVhost() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitmqVhostCreate,
+		ReadContext:   resourceDmsRabbitmqVhostRead,
+		DeleteContext: resourceDmsRabbitmqVhostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitmqVhostImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the vhost.`,
+			},
+			"tracing": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Indicates whether the message tracing is enabled.`,
+			},
+		},
+	}
+}
+
+// encodeRabbitmqVhostName encodes the vhost name used in the request path, the slashes must be replaced by
+// __F_SLASH__ as required by the API.
+func encodeRabbitmqVhostName(vhost string) string {
+	return strings.ReplaceAll(vhost, "/", "__F_SLASH__")
+}
+
+// buildRabbitmqManagementPath builds the path of the RabbitMQ management APIs, the vhost in the path is encoded.
+func buildRabbitmqManagementPath(client *golangsdk.ServiceClient, httpUrl, instanceID, vhost string) string {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceID)
+	return strings.ReplaceAll(path, "{vhost}", encodeRabbitmqVhostName(vhost))
+}
+
+// listRabbitmqManagementItems queries all items of the RabbitMQ management list APIs page by page.
+func listRabbitmqManagementItems(client *golangsdk.ServiceClient, path string) ([]interface{}, error) {
+	var (
+		offset int
+		result = make([]interface{}, 0)
+		opt    = golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+		}
+	)
+	for {
+		listPath := fmt.Sprintf("%s?limit=100&offset=%d", path, offset)
+		listResp, err := client.Request("GET", listPath, &opt)
+		if err != nil {
+			return nil, err
+		}
+		listRespBody, err := utils.FlattenResponse(listResp)
+		if err != nil {
+			return nil, err
+		}
+		items := utils.PathSearch("items", listRespBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, items...)
+		offset += len(items)
+		total := int(utils.PathSearch("total", listRespBody, float64(0)).(float64))
+		if len(items) == 0 || offset >= total {
+			return result, nil
+		}
+	}
+}
+
+// deleteRabbitmqManagementItem deletes an item by the batch deletion API of the RabbitMQ management APIs.
+func deleteRabbitmqManagementItem(client *golangsdk.ServiceClient, path, name string) error {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+		JSONBody: map[string]interface{}{
+			"name": []string{name},
+		},
+	}
+	_, err := client.Request("POST", path, &opt)
+	return err
+}
+
+func resourceDmsRabbitmqVhostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createRabbitmqVhost: create DMS RabbitMQ vhost
+	var (
+		createRabbitmqVhostHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts"
+		createRabbitmqVhostProduct = "dmsv2"
+	)
+	createRabbitmqVhostClient, err := cfg.NewServiceClient(createRabbitmqVhostProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	createRabbitmqVhostOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201,
+		},
+		JSONBody: map[string]interface{}{
+			"name": name,
+		},
+	}
+	_, err = createRabbitmqVhostClient.Request("POST",
+		buildRabbitmqManagementPath(createRabbitmqVhostClient, createRabbitmqVhostHttpUrl, instanceID, ""),
+		&createRabbitmqVhostOpt)
+	if err != nil {
+		return diag.Errorf("error creating DMS RabbitMQ vhost: %s", err)
+	}
+
+	d.SetId(instanceID + "/" + name)
+
+	return resourceDmsRabbitmqVhostRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitmqVhostRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getRabbitmqVhost: query DMS RabbitMQ vhost
+	var (
+		getRabbitmqVhostHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts"
+		getRabbitmqVhostProduct = "dmsv2"
+	)
+	getRabbitmqVhostClient, err := cfg.NewServiceClient(getRabbitmqVhostProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	vhosts, err := listRabbitmqManagementItems(getRabbitmqVhostClient,
+		buildRabbitmqManagementPath(getRabbitmqVhostClient, getRabbitmqVhostHttpUrl, instanceID, ""))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DMS RabbitMQ vhost")
+	}
+
+	vhost := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", name), vhosts, nil)
+	if vhost == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DMS RabbitMQ vhost")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("tracing", utils.PathSearch("tracing", vhost, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsRabbitmqVhostDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteRabbitmqVhost: delete DMS RabbitMQ vhost
+	var (
+		deleteRabbitmqVhostHttpUrl = "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/batch-delete"
+		deleteRabbitmqVhostProduct = "dmsv2"
+	)
+	deleteRabbitmqVhostClient, err := cfg.NewServiceClient(deleteRabbitmqVhostProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DMS Client: %s", err)
+	}
+
+	err = deleteRabbitmqManagementItem(deleteRabbitmqVhostClient,
+		buildRabbitmqManagementPath(deleteRabbitmqVhostClient, deleteRabbitmqVhostHttpUrl,
+			d.Get("instance_id").(string), ""), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DMS RabbitMQ vhost")
+	}
+
+	return nil
+}
+
+// resourceDmsRabbitmqVhostImportState imports the vhost by <instance_id>/<name>, the vhost name may contain slashes.
+func resourceDmsRabbitmqVhostImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<name>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
+
+// parseRabbitmqResourceID parses the ID in the format of <instance_id>/<vhost>/<suffix>..., the vhost may contain
+// slashes, so the instance ID is the first segment and the suffixCount segments at the end are returned as suffixes.
+func parseRabbitmqResourceID(id string, suffixCount int) (instanceID, vhost string, suffixes []string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < suffixCount+2 {
+		err = fmt.Errorf("invalid id format, the ID (%s) must contain the instance ID, the vhost and %d more parts",
+			id, suffixCount)
+		return
+	}
+	instanceID = parts[0]
+	vhost = strings.Join(parts[1:len(parts)-suffixCount], "/")
+	suffixes = parts[len(parts)-suffixCount:]
+	return
+}
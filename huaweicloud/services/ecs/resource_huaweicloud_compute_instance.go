@@ -3,6 +3,7 @@ package ecs
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"strings"
@@ -55,6 +56,8 @@ func ResourceComputeInstance() *schema.Resource {
 			StateContext: resourceComputeInstanceImportState,
 		},
 
+		CustomizeDiff: resourceComputeInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -86,14 +89,12 @@ func ResourceComputeInstance() *schema.Resource {
 			"image_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				DefaultFunc: schema.EnvDefaultFunc("HW_IMAGE_ID", nil),
 			},
 			"image_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				DefaultFunc: schema.EnvDefaultFunc("HW_IMAGE_NAME", nil),
 			},
@@ -119,6 +120,7 @@ func ResourceComputeInstance() *schema.Resource {
 			"key_pair": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"private_key": {
 				Type:      schema.TypeString,
@@ -142,10 +144,12 @@ func ResourceComputeInstance() *schema.Resource {
 				Set:      schema.HashString,
 			},
 			"network": {
-				Type:     schema.TypeList,
-				Required: true,
-				ForceNew: true,
-				MaxItems: 12,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				MaxItems:     12,
+				AtLeastOneOf: []string{"network", "template_id"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"uuid": {
@@ -213,6 +217,7 @@ func ResourceComputeInstance() *schema.Resource {
 			"data_disks": {
 				Type:          schema.TypeList,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: novaConflicts,
 				MaxItems:      23,
@@ -238,6 +243,16 @@ func ResourceComputeInstance() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"iops": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"throughput": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
 					},
 				},
 			},
@@ -276,10 +291,30 @@ func ResourceComputeInstance() *schema.Resource {
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
 				// just stash the hash for state & diff comparisons
 				StateFunc: utils.HashAndHexEncode,
 			},
+			"template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"template_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"template_id"},
+			},
+			"image_change_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "replace",
+				ValidateFunc: validation.StringInSlice([]string{
+					"replace", "reinstall",
+				}, false),
+			},
 			"stop_before_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -409,6 +444,7 @@ func ResourceComputeInstance() *schema.Resource {
 			"tags": {
 				Type:     schema.TypeMap,
 				Optional: true,
+				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"power_action": {
@@ -577,6 +613,11 @@ func resourceComputeInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("error creating networking client: %s", err)
 	}
 
+	templateMetadata, err := applyInstanceTemplate(d, cfg)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := validateComputeInstanceConfig(d, cfg); err != nil {
 		return diag.FromErr(err)
 	}
@@ -696,9 +737,13 @@ func resourceComputeInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		// Add password here so it wouldn't go in the above log entry
 		createOpts.AdminPass = d.Get("admin_pass").(string)
 
+		createOptsWithQoS := serverCreateOptsWithDataDiskQoS{
+			CreateOpts: createOpts,
+			DataDisks:  d.Get("data_disks").([]interface{}),
+		}
 		if d.Get("charging_mode") == "prePaid" {
 			// prePaid.
-			n, err := cloudservers.CreatePrePaid(ecsV11Client, createOpts).ExtractOrderResponse()
+			n, err := cloudservers.CreatePrePaid(ecsV11Client, createOptsWithQoS).ExtractOrderResponse()
 			if err != nil {
 				return diag.Errorf("error creating server: %s", err)
 			}
@@ -717,7 +762,7 @@ func resourceComputeInstanceCreate(ctx context.Context, d *schema.ResourceData,
 			d.SetId(resourceId)
 		} else {
 			// postPaid.
-			n, err := cloudservers.Create(ecsV11Client, createOpts).ExtractJobResponse()
+			n, err := cloudservers.Create(ecsV11Client, createOptsWithQoS).ExtractJobResponse()
 			if err != nil {
 				return diag.Errorf("error creating server: %s", err)
 			}
@@ -812,6 +857,17 @@ func resourceComputeInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// The metadata of the launch template can not be specified by the ECS creation API.
+	if len(templateMetadata) > 0 {
+		metadataOpts := make(servers.MetadataOpts)
+		for k, v := range templateMetadata {
+			metadataOpts[k] = fmt.Sprint(v)
+		}
+		if _, err := servers.UpdateMetadata(computeClient, d.Id(), metadataOpts).Extract(); err != nil {
+			return diag.Errorf("error setting the metadata of launch template to server (%s): %s", d.Id(), err)
+		}
+	}
+
 	// Create an instance in the shutdown state.
 	if action, ok := d.GetOk("power_action"); ok {
 		action := action.(string)
@@ -1152,7 +1208,16 @@ func resourceComputeInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("admin_pass") {
+	// The OS is reinstalled with the new password, key pair and user data, so there is no need to update them again.
+	var osReinstalled bool
+	if d.HasChanges(reinstallOSKeys...) {
+		if err := reinstallInstanceOS(d, cfg, ecsClient); err != nil {
+			return diag.FromErr(err)
+		}
+		osReinstalled = true
+	}
+
+	if d.HasChange("admin_pass") && !osReinstalled {
 		if newPwd, ok := d.Get("admin_pass").(string); ok {
 			err := cloudservers.ChangeAdminPassword(ecsClient, d.Id(), newPwd).ExtractErr()
 			if err != nil {
@@ -1279,7 +1344,7 @@ func resourceComputeInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// update the key_pair before power action
-	if d.HasChange("key_pair") {
+	if d.HasChange("key_pair") && !osReinstalled {
 		kmsClient, err := cfg.KmsV3Client(region)
 		if err != nil {
 			return diag.Errorf("error creating KMS v3 client: %s", err)
@@ -1419,6 +1484,7 @@ func resourceComputeInstanceImportState(_ context.Context, d *schema.ResourceDat
 
 	log.Printf("[DEBUG] flatten Instance Networks: %#v", networks)
 	d.Set("network", networks)
+	d.Set("image_change_strategy", "replace")
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -1508,6 +1574,83 @@ func validateComputeInstanceConfig(d *schema.ResourceData, conf *config.Config)
 	return nil
 }
 
+// applyInstanceTemplate fills the parameters which are not specified with the values of the launch template version,
+// and returns the template metadata which should be set after the instance is created.
+func applyInstanceTemplate(d *schema.ResourceData, cfg *config.Config) (map[string]interface{}, error) {
+	templateId := d.Get("template_id").(string)
+	if templateId == "" {
+		return nil, nil
+	}
+
+	client, err := cfg.NewServiceClient("ecs", cfg.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating ECS client: %s", err)
+	}
+
+	version := d.Get("template_version").(int)
+	if version == 0 {
+		template, err := GetComputeTemplate(client, templateId)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving ECS launch template (%s): %s", templateId, err)
+		}
+		version = int(utils.PathSearch("latest_version", template, float64(0)).(float64))
+	}
+	templateVersion, err := getComputeTemplateVersion(client, templateId, version)
+	if err != nil {
+		return nil, err
+	}
+	templateData := utils.PathSearch("template_data", templateVersion, nil)
+
+	inheritedParams := map[string]interface{}{
+		"flavor_id":          utils.PathSearch("flavor_id", templateData, nil),
+		"image_id":           utils.PathSearch("image_id", templateData, nil),
+		"availability_zone":  utils.PathSearch("availability_zone_id", templateData, nil),
+		"security_group_ids": utils.PathSearch("security_group_ids", templateData, nil),
+		"agency_name":        utils.PathSearch("os_profile.iam_agency_name", templateData, nil),
+		"system_disk_type": utils.PathSearch("block_device_mappings[?attachment.boot_index==`0`]|[0].volume_type",
+			templateData, nil),
+		"system_disk_size": utils.PathSearch("block_device_mappings[?attachment.boot_index==`0`]|[0].volume_size",
+			templateData, nil),
+		"key_pair":  utils.PathSearch("os_profile.key_name", templateData, nil),
+		"user_data": utils.PathSearch("os_profile.user_data", templateData, nil),
+	}
+	if tags := utils.FlattenTagsToMap(utils.PathSearch("tag_options[0].tags", templateData, nil)); len(tags) > 0 {
+		inheritedParams["tags"] = tags
+	}
+	if dataDisks := flattenComputeTemplateDataDisks(templateData); len(dataDisks) > 0 {
+		inheritedParams["data_disks"] = dataDisks
+	}
+	subnetIds := utils.PathSearch("network_interfaces[*].virsubnet_id", templateData,
+		make([]interface{}, 0)).([]interface{})
+	if len(subnetIds) > 0 {
+		networks := make([]map[string]interface{}, len(subnetIds))
+		for i, subnetId := range subnetIds {
+			networks[i] = map[string]interface{}{
+				"uuid":              subnetId,
+				"source_dest_check": true,
+			}
+		}
+		inheritedParams["network"] = networks
+	}
+
+	for key, value := range inheritedParams {
+		// The network blocks are an empty list rather than null when they are not specified.
+		rawValue := d.GetRawConfig().GetAttr(key)
+		if value == nil || (!rawValue.IsNull() && (!rawValue.Type().IsListType() || rawValue.LengthInt() > 0)) {
+			continue
+		}
+		if err = d.Set(key, value); err != nil {
+			return nil, fmt.Errorf("error setting %s from ECS launch template (%s): %s", key, templateId, err)
+		}
+	}
+
+	var metadata map[string]interface{}
+	if d.GetRawConfig().GetAttr("metadata").IsNull() {
+		metadata, _ = utils.PathSearch("metadata", templateData, nil).(map[string]interface{})
+	}
+	return metadata, d.Set("template_version", version)
+}
+
 func buildInstanceNicsRequest(d *schema.ResourceData) []cloudservers.Nic {
 	var nicRequests []cloudservers.Nic
 
@@ -1633,6 +1776,121 @@ func resourceInstanceSchedulerHintsV2(schedulerHintsRaw map[string]interface{})
 	return schedulerHints
 }
 
+// reinstallOSKeys are the parameters which can be updated by reinstalling or changing the OS when the
+// image_change_strategy is reinstall, otherwise a new instance is created.
+var reinstallOSKeys = []string{"image_id", "image_name", "user_data"}
+
+// templateComputedKeys are the optional parameters which are computed only because they can be inherited from the
+// launch template, and their empty values.
+var templateComputedKeys = map[string]interface{}{
+	"key_pair":   "",
+	"user_data":  "",
+	"tags":       map[string]interface{}{},
+	"data_disks": []interface{}{},
+}
+
+func resourceComputeInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// Without a launch template, removing these parameters from the configuration still clears them.
+	if d.Get("template_id").(string) == "" {
+		for key, emptyValue := range templateComputedKeys {
+			rawValue := d.GetRawConfig().GetAttr(key)
+			isEmpty := rawValue.IsNull() ||
+				(rawValue.IsKnown() && rawValue.CanIterateElements() && rawValue.LengthInt() == 0)
+			if _, ok := d.GetOk(key); ok && isEmpty {
+				if err := d.SetNew(key, emptyValue); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if d.Get("image_change_strategy").(string) != "reinstall" {
+		for _, key := range reinstallOSKeys {
+			if d.HasChange(key) {
+				if err := d.ForceNew(key); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	// Only one of the image ID and image name is specified, the other one is refreshed after the OS is changed.
+	if d.HasChange("image_id") && !d.HasChange("image_name") {
+		return d.SetNewComputed("image_name")
+	}
+	if d.HasChange("image_name") && !d.HasChange("image_id") {
+		return d.SetNewComputed("image_id")
+	}
+	return nil
+}
+
+// reinstallInstanceOS reinstalls the OS of the instance, or changes the OS when the image is changed. The network
+// interfaces and data disks of the instance are kept.
+func reinstallInstanceOS(d *schema.ResourceData, cfg *config.Config, client *golangsdk.ServiceClient) error {
+	reinstallOpts := map[string]interface{}{
+		"adminpass": utils.ValueIngoreEmpty(d.Get("admin_pass")),
+		"mode":      "withStopServer",
+	}
+	if keyPair := d.Get("key_pair").(string); keyPair != "" {
+		reinstallOpts["keyname"] = keyPair
+		reinstallOpts["userid"] = getOpSvcUserID(d, cfg)
+	}
+	if userData := d.Get("user_data").(string); userData != "" {
+		if _, err := base64.StdEncoding.DecodeString(userData); err != nil {
+			userData = base64.StdEncoding.EncodeToString([]byte(userData))
+		}
+		reinstallOpts["metadata"] = map[string]interface{}{
+			"user_data": userData,
+		}
+	}
+
+	action, bodyKey := "reinstallos", "os-reinstall"
+	if d.HasChanges("image_id", "image_name") {
+		imsClient, err := cfg.ImageV2Client(cfg.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("error creating image client: %s", err)
+		}
+		imageID, err := getImageIDFromConfig(imsClient, d)
+		if err != nil {
+			return err
+		}
+		reinstallOpts["imageid"] = imageID
+		action, bodyKey = "changeos", "os-change"
+	}
+
+	reinstallPath := client.Endpoint + "v2/{project_id}/cloudservers/{server_id}/" + action
+	reinstallPath = strings.ReplaceAll(reinstallPath, "{project_id}", client.ProjectID)
+	reinstallPath = strings.ReplaceAll(reinstallPath, "{server_id}", d.Id())
+	reinstallOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			bodyKey: utils.RemoveNil(reinstallOpts),
+		},
+	}
+	resp, err := client.Request("POST", reinstallPath, &reinstallOpt)
+	if err != nil {
+		return fmt.Errorf("error reinstalling the OS of instance (%s): %s", d.Id(), err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+	jobID := utils.PathSearch("job_id", respBody, "").(string)
+	if err := cloudservers.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), jobID); err != nil {
+		return fmt.Errorf("error waiting for the OS of instance (%s) to be reinstalled: %s", d.Id(), err)
+	}
+	return nil
+}
+
 func getImage(client *golangsdk.ServiceClient, id, name string) (*cloudimages.Image, error) {
 	listOpts := &cloudimages.ListOpts{
 		ID:                  id,
@@ -1994,6 +2252,39 @@ func resourceInstanceRootVolume(d *schema.ResourceData) cloudservers.RootVolume
 	return volRequest
 }
 
+// serverCreateOptsWithDataDiskQoS adds the provisioned IOPS and throughput of the data disks, which are not supported
+// by cloudservers.DataVolume, to the request body.
+type serverCreateOptsWithDataDiskQoS struct {
+	*cloudservers.CreateOpts
+	DataDisks []interface{}
+}
+
+func (opts serverCreateOptsWithDataDiskQoS) ToServerCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	dataVolumes := utils.PathSearch("server.data_volumes", b, make([]interface{}, 0)).([]interface{})
+	for i, v := range dataVolumes {
+		if i >= len(opts.DataDisks) {
+			break
+		}
+		dataVolume, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		disk := opts.DataDisks[i].(map[string]interface{})
+		if iops := disk["iops"].(int); iops > 0 {
+			dataVolume["iops"] = iops
+		}
+		if throughput := disk["throughput"].(int); throughput > 0 {
+			dataVolume["throughput"] = throughput
+		}
+	}
+	return b, nil
+}
+
 func resourceInstanceDataVolumes(d *schema.ResourceData) []cloudservers.DataVolume {
 	var volRequests []cloudservers.DataVolume
 
@@ -78,7 +78,7 @@ func ResourceComputeInterfaceAttach() *schema.Resource {
 			"source_dest_check": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				Default:  true,
+				Computed: true,
 			},
 			"mac": {
 				Type:     schema.TypeString,
@@ -88,21 +88,38 @@ func ResourceComputeInterfaceAttach() *schema.Resource {
 	}
 }
 
+// updateInterfacePort updates the security groups and the source/destination check of the port, the nil values are
+// not changed. The allowed address pairs of the port, except the one to disable the source/destination check, are
+// kept.
 func updateInterfacePort(client *golangsdk.ServiceClient, portId string, securityGroupIds []string,
-	sourceDestCheckEnabled bool) error {
-	opts := ports.UpdateOpts{
-		AllowedAddressPairs: nil,
-		SecurityGroups:      &securityGroupIds,
-	}
-	if !sourceDestCheckEnabled {
-		// Update the allowed-address-pairs of the port to 1.1.1.1/0
-		// to disable the source/destination check
-		portpairs := []ports.AddressPair{
-			{
-				IPAddress: "1.1.1.1/0",
-			},
+	sourceDestCheckEnabled *bool) error {
+	var opts ports.UpdateOpts
+	if securityGroupIds != nil {
+		opts.SecurityGroups = &securityGroupIds
+	}
+	if sourceDestCheckEnabled != nil {
+		port, err := ports.Get(client, portId).Extract()
+		if err != nil {
+			return err
+		}
+
+		portPairs := make([]ports.AddressPair, 0, len(port.AllowedAddressPairs))
+		for _, pair := range port.AllowedAddressPairs {
+			if pair.IPAddress != common.SourceDestCheckDisabledAddress {
+				portPairs = append(portPairs, pair)
+			}
+		}
+		if !*sourceDestCheckEnabled {
+			// Update the allowed-address-pairs of the port to 1.1.1.1/0
+			// to disable the source/destination check
+			portPairs = append(portPairs, ports.AddressPair{
+				IPAddress: common.SourceDestCheckDisabledAddress,
+			})
 		}
-		opts.AllowedAddressPairs = &portpairs
+		opts.AllowedAddressPairs = &portPairs
+	}
+	if opts.SecurityGroups == nil && opts.AllowedAddressPairs == nil {
+		return nil
 	}
 
 	_, err := ports.Update(client, portId, opts).Extract()
@@ -168,11 +185,19 @@ func resourceComputeInterfaceAttachCreate(ctx context.Context, d *schema.Resourc
 	id := fmt.Sprintf("%s/%s", instanceId, portID)
 	d.SetId(id)
 
+	// The security groups and the source/destination check of an existing port are only changed if they are
+	// specified, so the settings of the port managed by the network interface resource are kept.
 	var (
-		securityGroupIds       = d.Get("security_group_ids").([]interface{})
-		sourceDestCheckEnabled = d.Get("source_dest_check").(bool)
+		securityGroupIds       []string
+		sourceDestCheckEnabled *bool
 	)
-	err = updateInterfacePort(nicClient, portID, utils.ExpandToStringList(securityGroupIds), sourceDestCheckEnabled)
+	if networkId != "" || !d.GetRawConfig().GetAttr("security_group_ids").IsNull() {
+		securityGroupIds = utils.ExpandToStringList(d.Get("security_group_ids").([]interface{}))
+	}
+	if !d.GetRawConfig().GetAttr("source_dest_check").IsNull() {
+		sourceDestCheckEnabled = utils.Bool(d.Get("source_dest_check").(bool))
+	}
+	err = updateInterfacePort(nicClient, portID, securityGroupIds, sourceDestCheckEnabled)
 	if err != nil {
 		return diag.Errorf("error updating VPC port (%s): %s", portID, err)
 	}
@@ -215,7 +240,12 @@ func resourceComputeInterfaceAttachRead(_ context.Context, d *schema.ResourceDat
 	if port, err := ports.Get(networkingClient, attachment.PortID).Extract(); err == nil {
 		macAddress = port.MACAddress
 		securitygroups = port.SecurityGroups
-		sdCheck = len(port.AllowedAddressPairs) == 0
+		sdCheck = true
+		for _, pair := range port.AllowedAddressPairs {
+			if pair.IPAddress == common.SourceDestCheckDisabledAddress {
+				sdCheck = false
+			}
+		}
 	}
 
 	mErr := multierror.Append(nil,
@@ -241,10 +271,16 @@ func resourceComputeInterfaceAttachUpdate(ctx context.Context, d *schema.Resourc
 
 	var (
 		portId                 = d.Get("port_id").(string)
-		securityGroupIds       = d.Get("security_group_ids").([]interface{})
-		sourceDestCheckEnabled = d.Get("source_dest_check").(bool)
+		securityGroupIds       []string
+		sourceDestCheckEnabled *bool
 	)
-	err = updateInterfacePort(nicClient, portId, utils.ExpandToStringList(securityGroupIds), sourceDestCheckEnabled)
+	if d.HasChange("security_group_ids") {
+		securityGroupIds = utils.ExpandToStringList(d.Get("security_group_ids").([]interface{}))
+	}
+	if d.HasChange("source_dest_check") {
+		sourceDestCheckEnabled = utils.Bool(d.Get("source_dest_check").(bool))
+	}
+	err = updateInterfacePort(nicClient, portId, securityGroupIds, sourceDestCheckEnabled)
 	if err != nil {
 		return diag.Errorf("error updating VPC port (%s): %s", portId, err)
 	}
@@ -0,0 +1,487 @@
+package ecs
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// computeTemplateDataKeys are the parameters stored in the template versions, a new version is created when any of
+// them is changed.
+var computeTemplateDataKeys = []string{
+	"flavor_id", "image_id", "availability_zone", "key_pair", "user_data", "security_group_ids", "network",
+	"system_disk_type", "system_disk_size", "data_disks", "agency_name", "tags", "metadata",
+}
+
+func ResourceComputeTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceComputeTemplateCreate,
+		ReadContext:   resourceComputeTemplateRead,
+		UpdateContext: resourceComputeTemplateUpdate,
+		DeleteContext: resourceComputeTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the launch template.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the description of the launch template.`,
+			},
+			"version_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the description of the latest template version.`,
+			},
+			"flavor_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the flavor ID of the instances.`,
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the image ID of the instances.`,
+			},
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the availability zone of the instances.`,
+			},
+			"key_pair": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the SSH key pair name used for logging in to the instances.`,
+			},
+			"user_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the user data to be injected to the instances.`,
+			},
+			"security_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: `Specifies the security group IDs of the instances.`,
+			},
+			"network": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 12,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the network ID (subnet ID) of the NIC.`,
+						},
+					},
+				},
+				Description: `Specifies the NICs of the instances.`,
+			},
+			"system_disk_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the system disk type of the instances.`,
+			},
+			"system_disk_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: `Specifies the system disk size of the instances, in GB.`,
+			},
+			"data_disks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 23,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the type of the data disk.`,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Specifies the size of the data disk, in GB.`,
+						},
+					},
+				},
+				Description: `Specifies the data disks of the instances.`,
+			},
+			"agency_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the IAM agency name of the instances.`,
+			},
+			"tags": common.TagsSchema(),
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the metadata of the instances.`,
+			},
+			"default_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The default version of the launch template.`,
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `The latest version of the launch template.`,
+			},
+		},
+	}
+}
+
+func buildComputeTemplateNetworkInterfaces(networks []interface{}) []map[string]interface{} {
+	if len(networks) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(networks))
+	for i, v := range networks {
+		result[i] = map[string]interface{}{
+			"virsubnet_id": v.(map[string]interface{})["uuid"],
+			"attachment": map[string]interface{}{
+				"device_index": i,
+			},
+		}
+	}
+	return result
+}
+
+func buildComputeTemplateBlockDevices(d *schema.ResourceData) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if v, ok := d.GetOk("system_disk_type"); ok {
+		result = append(result, map[string]interface{}{
+			"source_type": "image",
+			"volume_type": v,
+			"volume_size": utils.ValueIngoreEmpty(d.Get("system_disk_size")),
+			"attachment": map[string]interface{}{
+				"boot_index": 0,
+			},
+		})
+	}
+	for _, v := range d.Get("data_disks").([]interface{}) {
+		disk := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"source_type": "blank",
+			"volume_type": disk["type"],
+			"volume_size": disk["size"],
+		})
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func buildComputeTemplateOsProfile(d *schema.ResourceData) map[string]interface{} {
+	osProfile := utils.RemoveNil(map[string]interface{}{
+		"key_name":        utils.ValueIngoreEmpty(d.Get("key_pair")),
+		"iam_agency_name": utils.ValueIngoreEmpty(d.Get("agency_name")),
+	})
+	if userData := d.Get("user_data").(string); userData != "" {
+		if _, err := base64.StdEncoding.DecodeString(userData); err != nil {
+			userData = base64.StdEncoding.EncodeToString([]byte(userData))
+		}
+		osProfile["user_data"] = userData
+	}
+	if len(osProfile) == 0 {
+		return nil
+	}
+	return osProfile
+}
+
+func buildComputeTemplateTagOptions(tags map[string]interface{}) []map[string]interface{} {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"tags": utils.ExpandResourceTagsMap(tags),
+		},
+	}
+}
+
+func buildComputeTemplateDataParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"flavor_id":             utils.ValueIngoreEmpty(d.Get("flavor_id")),
+		"image_id":              utils.ValueIngoreEmpty(d.Get("image_id")),
+		"availability_zone_id":  utils.ValueIngoreEmpty(d.Get("availability_zone")),
+		"security_group_ids":    utils.ValueIngoreEmpty(d.Get("security_group_ids").(*schema.Set).List()),
+		"network_interfaces":    buildComputeTemplateNetworkInterfaces(d.Get("network").([]interface{})),
+		"block_device_mappings": buildComputeTemplateBlockDevices(d),
+		"os_profile":            buildComputeTemplateOsProfile(d),
+		"tag_options":           buildComputeTemplateTagOptions(d.Get("tags").(map[string]interface{})),
+		"metadata":              utils.ValueIngoreEmpty(d.Get("metadata")),
+	}
+}
+
+func resourceComputeTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ecs", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ECS client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v3/{project_id}/launch-templates"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			"launch_template": utils.RemoveNil(map[string]interface{}{
+				"name":                d.Get("name"),
+				"description":         utils.ValueIngoreEmpty(d.Get("description")),
+				"version_description": utils.ValueIngoreEmpty(d.Get("version_description")),
+				"template_data":       utils.RemoveNil(buildComputeTemplateDataParams(d)),
+			}),
+		},
+	}
+	createResp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating ECS launch template: %s", err)
+	}
+
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := utils.PathSearch("launch_template_id", createRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the launch template ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceComputeTemplateRead(ctx, d, meta)
+}
+
+func createComputeTemplateVersion(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	createPath := client.Endpoint + "v3/{project_id}/launch-template-versions"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"launch_template_id":  d.Id(),
+			"version_description": utils.ValueIngoreEmpty(d.Get("version_description")),
+			"template_data":       utils.RemoveNil(buildComputeTemplateDataParams(d)),
+		}),
+	}
+	_, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return fmt.Errorf("error creating the version of ECS launch template (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+// GetComputeTemplate queries the launch template by ID.
+func GetComputeTemplate(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	getPath := client.Endpoint + "v3/{project_id}/launch-templates?launch_template_id={id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{id}", id)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+	template := utils.PathSearch(fmt.Sprintf("launch_templates[?id=='%s']|[0]", id), getRespBody, nil)
+	if template == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return template, nil
+}
+
+// getComputeTemplateVersion queries the specified version of the launch template.
+func getComputeTemplateVersion(client *golangsdk.ServiceClient, id string, version int) (interface{}, error) {
+	getPath := client.Endpoint + "v3/{project_id}/launch-template-versions?launch_template_id={id}&version={version}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{id}", id)
+	getPath = strings.ReplaceAll(getPath, "{version}", fmt.Sprint(version))
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+	templateVersion := utils.PathSearch(fmt.Sprintf("launch_template_versions[?version_number==`%d`]|[0]", version),
+		getRespBody, nil)
+	if templateVersion == nil {
+		return nil, fmt.Errorf("the version (%d) of ECS launch template (%s) is not found", version, id)
+	}
+	return templateVersion, nil
+}
+
+func resourceComputeTemplateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("ecs", region)
+	if err != nil {
+		return diag.Errorf("error creating ECS client: %s", err)
+	}
+
+	template, err := GetComputeTemplate(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving ECS launch template")
+	}
+
+	latestVersion := int(utils.PathSearch("latest_version", template, float64(0)).(float64))
+	templateVersion, err := getComputeTemplateVersion(client, d.Id(), latestVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateData := utils.PathSearch("template_data", templateVersion, nil)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", template, nil)),
+		d.Set("description", utils.PathSearch("description", template, nil)),
+		d.Set("default_version", utils.PathSearch("default_version", template, nil)),
+		d.Set("latest_version", latestVersion),
+		d.Set("flavor_id", utils.PathSearch("flavor_id", templateData, nil)),
+		d.Set("image_id", utils.PathSearch("image_id", templateData, nil)),
+		d.Set("availability_zone", utils.PathSearch("availability_zone_id", templateData, nil)),
+		d.Set("key_pair", utils.PathSearch("os_profile.key_name", templateData, nil)),
+		d.Set("agency_name", utils.PathSearch("os_profile.iam_agency_name", templateData, nil)),
+		d.Set("security_group_ids", utils.PathSearch("security_group_ids", templateData, nil)),
+		d.Set("network", flattenComputeTemplateNetworks(templateData)),
+		d.Set("system_disk_type", utils.PathSearch("block_device_mappings[?attachment.boot_index==`0`]|[0].volume_type",
+			templateData, nil)),
+		d.Set("system_disk_size", utils.PathSearch("block_device_mappings[?attachment.boot_index==`0`]|[0].volume_size",
+			templateData, nil)),
+		d.Set("data_disks", flattenComputeTemplateDataDisks(templateData)),
+		d.Set("tags", utils.FlattenTagsToMap(utils.PathSearch("tag_options[0].tags", templateData, nil))),
+		d.Set("metadata", utils.PathSearch("metadata", templateData, nil)),
+	)
+	// Changing only the version description does not create a new version, so it is refreshed only when it is
+	// unknown, e.g. after importing.
+	if _, ok := d.GetOk("version_description"); !ok {
+		mErr = multierror.Append(mErr,
+			d.Set("version_description", utils.PathSearch("version_description", templateVersion, nil)))
+	}
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenComputeTemplateNetworks(templateData interface{}) []map[string]interface{} {
+	networksRaw := utils.PathSearch("network_interfaces", templateData, make([]interface{}, 0)).([]interface{})
+	if len(networksRaw) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(networksRaw))
+	for i, v := range networksRaw {
+		result[i] = map[string]interface{}{
+			"uuid": utils.PathSearch("virsubnet_id", v, nil),
+		}
+	}
+	return result
+}
+
+func flattenComputeTemplateDataDisks(templateData interface{}) []map[string]interface{} {
+	disksRaw := utils.PathSearch("block_device_mappings[?attachment.boot_index!=`0`]", templateData,
+		make([]interface{}, 0)).([]interface{})
+	if len(disksRaw) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(disksRaw))
+	for i, v := range disksRaw {
+		result[i] = map[string]interface{}{
+			"type": utils.PathSearch("volume_type", v, nil),
+			"size": utils.PathSearch("volume_size", v, nil),
+		}
+	}
+	return result
+}
+
+func resourceComputeTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ecs", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ECS client: %s", err)
+	}
+
+	if d.HasChanges(computeTemplateDataKeys...) {
+		if err = createComputeTemplateVersion(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceComputeTemplateRead(ctx, d, meta)
+}
+
+func resourceComputeTemplateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ecs", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating ECS client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/launch-templates/{id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+	}
+	_, err = client.Request("DELETE", deletePath, &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting ECS launch template")
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package evs
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
 	"github.com/chnsz/golangsdk/openstack/evs/v2/cloudvolumes"
@@ -117,6 +119,18 @@ func DataSourceEvsVolumesV2() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"volume_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iops": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"throughput": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"status": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -172,11 +186,14 @@ func sourceEvsAttachment(attachements []cloudvolumes.Attachment, mode string) []
 	return result
 }
 
-func sourceEvsVolumes(volumes []cloudvolumes.Volume) ([]map[string]interface{}, []string, error) {
+// sourceEvsVolumes flattens the volumes, the IOPS and throughput which are not contained in cloudvolumes.Volume are
+// obtained from the response body.
+func sourceEvsVolumes(volumes []cloudvolumes.Volume, respBody interface{}) ([]map[string]interface{}, []string, error) {
 	result := make([]map[string]interface{}, len(volumes))
 	ids := make([]string, len(volumes))
 
 	for i, volume := range volumes {
+		rawVolume := utils.PathSearch(fmt.Sprintf("volumes[?id=='%s']|[0]", volume.ID), respBody, nil)
 		vMap := map[string]interface{}{
 			"id":                    volume.ID,
 			"attachments":           sourceEvsAttachment(volume.Attachments, volume.Metadata.AttachedMode),
@@ -187,6 +204,9 @@ func sourceEvsVolumes(volumes []cloudvolumes.Volume) ([]map[string]interface{},
 			"service_type":          volume.ServiceType,
 			"shareable":             volume.Multiattach,
 			"size":                  volume.Size,
+			"volume_type":           volume.VolumeType,
+			"iops":                  utils.PathSearch("iops.total_val", rawVolume, nil),
+			"throughput":            utils.PathSearch("throughput.total_val", rawVolume, nil),
 			"status":                volume.Status,
 			"create_at":             volume.CreatedAt,
 			"update_at":             volume.UpdatedAt,
@@ -228,7 +248,17 @@ func dataSourceEvsVolumesV2Read(_ context.Context, d *schema.ResourceData, meta
 	filterVolumes := filterVolumeListByTags(volumes, filter)
 	logp.Printf("filter %d EVS volumes from %d through options %v", len(filterVolumes), len(volumes), filter)
 
-	vMap, ids, err := sourceEvsVolumes(filterVolumes)
+	// The concatenated body of all pages is not a map[string]interface{}, convert it to search the volumes.
+	var respBody interface{}
+	bodyBytes, err := json.Marshal(pages.GetBody())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = json.Unmarshal(bodyBytes, &respBody); err != nil {
+		return diag.FromErr(err)
+	}
+
+	vMap, ids, err := sourceEvsVolumes(filterVolumes, respBody)
 	if err != nil {
 		return diag.FromErr(err)
 	}
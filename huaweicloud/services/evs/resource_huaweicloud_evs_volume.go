@@ -57,7 +57,6 @@ func ResourceEvsVolume() *schema.Resource {
 			"volume_type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"device_type": {
 				Type:         schema.TypeString,
@@ -80,6 +79,16 @@ func ResourceEvsVolume() *schema.Resource {
 				Computed:     true,
 				AtLeastOneOf: []string{"size", "backup_id"},
 			},
+			"iops": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"throughput": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
 			"backup_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -207,6 +216,31 @@ func buildEvsVolumeCreateOpts(d *schema.ResourceData, config *config.Config) clo
 	return result
 }
 
+// volumeCreateOptsWithQoS adds the provisioned IOPS and throughput, which are not supported by
+// cloudvolumes.VolumeOpts, to the request body.
+type volumeCreateOptsWithQoS struct {
+	cloudvolumes.CreateOpts
+	IOPS       int
+	Throughput int
+}
+
+func (opts volumeCreateOptsWithQoS) ToVolumeCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToVolumeCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if volume, ok := b["volume"].(map[string]interface{}); ok {
+		if opts.IOPS > 0 {
+			volume["iops"] = opts.IOPS
+		}
+		if opts.Throughput > 0 {
+			volume["throughput"] = opts.Throughput
+		}
+	}
+	return b, nil
+}
+
 func resourceEvsVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	// The v2 client is used to obtain the volume detail.
@@ -220,7 +254,11 @@ func resourceEvsVolumeCreate(ctx context.Context, d *schema.ResourceData, meta i
 		return fmtp.DiagErrorf("Error creating HuaweiCloud block storage v2.1 client: %s", err)
 	}
 
-	opt := buildEvsVolumeCreateOpts(d, config)
+	opt := volumeCreateOptsWithQoS{
+		CreateOpts: buildEvsVolumeCreateOpts(d, config),
+		IOPS:       d.Get("iops").(int),
+		Throughput: d.Get("throughput").(int),
+	}
 	logp.Printf("[DEBUG] Create Options: %#v", opt)
 	job, err := cloudvolumes.Create(evsV21Client, opt).Extract()
 	if err != nil {
@@ -305,7 +343,8 @@ func resourceEvsVolumeRead(_ context.Context, d *schema.ResourceData, meta inter
 		return fmtp.DiagErrorf("Error creating HuaweiCloud block storage v2 client: %s", err)
 	}
 
-	resp, err := cloudvolumes.Get(evsV2Client, d.Id()).Extract()
+	getResult := cloudvolumes.Get(evsV2Client, d.Id())
+	resp, err := getResult.Extract()
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "EVS volume")
 	}
@@ -318,6 +357,9 @@ func resourceEvsVolumeRead(_ context.Context, d *schema.ResourceData, meta inter
 		d.Set("availability_zone", resp.AvailabilityZone),
 		d.Set("snapshot_id", resp.SnapshotID),
 		d.Set("volume_type", resp.VolumeType),
+		// The IOPS and throughput are only returned for the volume types which support provisioned performance.
+		d.Set("iops", utils.PathSearch("volume.iops.total_val", getResult.Body, nil)),
+		d.Set("throughput", utils.PathSearch("volume.throughput.total_val", getResult.Body, nil)),
 		d.Set("enterprise_project_id", resp.EnterpriseProjectID),
 		d.Set("region", config.GetRegion(d)),
 		d.Set("wwn", resp.WWN),
@@ -411,6 +453,17 @@ func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	// Changing the volume type also changes the IOPS and throughput to the specified values.
+	if d.HasChange("volume_type") {
+		if err = retypeEvsVolume(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChanges("iops", "throughput") {
+		if err = updateEvsVolumeQoS(ctx, d, config); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("auto_renew") {
 		bssClient, err := config.BssV2Client(config.GetRegion(d))
 		if err != nil {
@@ -424,6 +477,171 @@ func resourceEvsVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	return resourceEvsVolumeRead(ctx, d, meta)
 }
 
+func buildEvsVolumePath(client *golangsdk.ServiceClient, httpUrl, volumeId string) string {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{volume_id}", volumeId)
+	return path
+}
+
+// getConfiguredVolumeQoS returns the IOPS or throughput only when it is specified, because the value of the old volume
+// type is kept in the state when it is omitted, and it may be not supported by the new volume type.
+func getConfiguredVolumeQoS(d *schema.ResourceData, key string) interface{} {
+	if v := d.GetRawConfig().GetAttr(key); v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	return utils.ValueIngoreEmpty(d.Get(key))
+}
+
+func retypeEvsVolume(ctx context.Context, d *schema.ResourceData, cfg *config.Config) error {
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("evs", region)
+	if err != nil {
+		return fmt.Errorf("error creating EVS client: %s", err)
+	}
+
+	retypeParams := map[string]interface{}{
+		"os-retype": map[string]interface{}{
+			"new_type":   d.Get("volume_type"),
+			"iops":       getConfiguredVolumeQoS(d, "iops"),
+			"throughput": getConfiguredVolumeQoS(d, "throughput"),
+		},
+	}
+	isPrePaid := strings.EqualFold(d.Get("charging_mode").(string), "prePaid")
+	if isPrePaid {
+		retypeParams["bssParam"] = map[string]interface{}{
+			"isAutoPay": common.GetAutoPay(d),
+		}
+	}
+
+	retypeOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202,
+		},
+		JSONBody: utils.RemoveNil(retypeParams),
+	}
+	resp, err := client.Request("POST", buildEvsVolumePath(client, "v5/{project_id}/cloudvolumes/{volume_id}/retype",
+		d.Id()), &retypeOpt)
+	if err != nil {
+		return fmt.Errorf("error changing the type of EVS volume (%s): %s", d.Id(), err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+	if isPrePaid {
+		bssClient, err := cfg.BssV2Client(region)
+		if err != nil {
+			return fmt.Errorf("error creating BSS v2 client: %s", err)
+		}
+		orderId := utils.PathSearch("order_id", respBody, "").(string)
+		if err = common.WaitOrderComplete(ctx, bssClient, orderId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("the order (%s) is not completed while changing the type of EVS volume (%s): %s",
+				orderId, d.Id(), err)
+		}
+	}
+
+	// The job ID is only returned for the pay-per-use volume.
+	if jobId := utils.PathSearch("job_id", respBody, "").(string); jobId != "" {
+		if err = waitForEvsJobSuccess(ctx, client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for the type of EVS volume (%s) to be changed: %s", d.Id(), err)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retyping"},
+		Target:     []string{"available", "in-use"},
+		Refresh:    CloudVolumeRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the type of EVS volume (%s) to be changed: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func updateEvsVolumeQoS(ctx context.Context, d *schema.ResourceData, cfg *config.Config) error {
+	client, err := cfg.NewServiceClient("evs", cfg.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating EVS client: %s", err)
+	}
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202,
+		},
+		JSONBody: map[string]interface{}{
+			"qos_modify": utils.RemoveNil(map[string]interface{}{
+				"iops":       utils.ValueIngoreEmpty(d.Get("iops")),
+				"throughput": utils.ValueIngoreEmpty(d.Get("throughput")),
+			}),
+		},
+	}
+	resp, err := client.Request("PUT", buildEvsVolumePath(client, "v5/{project_id}/cloudvolumes/{volume_id}/qos",
+		d.Id()), &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating the IOPS and throughput of EVS volume (%s): %s", d.Id(), err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+	jobId := utils.PathSearch("job_id", respBody, "").(string)
+	if err = waitForEvsJobSuccess(ctx, client, jobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for the IOPS and throughput of EVS volume (%s) to be updated: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func waitForEvsJobSuccess(ctx context.Context, client *golangsdk.ServiceClient, jobId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"INIT", "RUNNING"},
+		Target:     []string{"SUCCESS"},
+		Refresh:    evsJobRefreshFunc(client, jobId),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func evsJobRefreshFunc(client *golangsdk.ServiceClient, jobId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getPath := client.Endpoint + "v1/{project_id}/jobs/{job_id}"
+		getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+		getPath = strings.ReplaceAll(getPath, "{job_id}", jobId)
+		getOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+		}
+		resp, err := client.Request("GET", getPath, &getOpt)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		status := utils.PathSearch("status", respBody, "").(string)
+		if status == "FAIL" {
+			return respBody, status, fmt.Errorf("the job (%s) failed: %v", jobId,
+				utils.PathSearch("fail_reason", respBody, nil))
+		}
+		return respBody, status, nil
+	}
+}
+
 func resourceContainerTags(d *schema.ResourceData) map[string]string {
 	m := make(map[string]string)
 	for key, val := range d.Get("tags").(map[string]interface{}) {
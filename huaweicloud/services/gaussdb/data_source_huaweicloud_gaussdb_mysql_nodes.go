@@ -0,0 +1,224 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceGaussDBMysqlNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGaussDBMysqlNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the GaussDB MySQL instance.`,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"master", "slave"}, false),
+				Description:  `Specifies the role of the nodes.`,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the ID of the node.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the node.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the role of the node.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the status of the node.`,
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the failover priority of the node.`,
+						},
+						"flavor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the flavor of the node.`,
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the availability zone of the node.`,
+						},
+						"private_read_ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the private read IP address of the node.`,
+						},
+						"weight": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the read weight of the node in the proxy.`,
+						},
+						"replication_delay": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the replication delay of the read-only node, in seconds.`,
+						},
+					},
+				},
+				Description: `Indicates the list of the nodes.`,
+			},
+		},
+	}
+}
+
+func dataSourceGaussDBMysqlNodesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getMysqlInstance: query the nodes of the GaussDB MySQL instance
+	var (
+		getMysqlInstanceHttpUrl = "v3/{project_id}/instances/{instance_id}"
+		getMysqlInstanceProduct = "gaussdb"
+	)
+	getMysqlInstanceClient, err := cfg.NewServiceClient(getMysqlInstanceProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	getMysqlInstancePath := getMysqlInstanceClient.Endpoint + getMysqlInstanceHttpUrl
+	getMysqlInstancePath = strings.ReplaceAll(getMysqlInstancePath, "{project_id}", getMysqlInstanceClient.ProjectID)
+	getMysqlInstancePath = strings.ReplaceAll(getMysqlInstancePath, "{instance_id}", instanceID)
+
+	getMysqlInstanceOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getMysqlInstanceResp, err := getMysqlInstanceClient.Request("GET", getMysqlInstancePath, &getMysqlInstanceOpt)
+	if err != nil {
+		return diag.Errorf("error retrieving GaussDB MySQL instance (%s): %s", instanceID, err)
+	}
+
+	getMysqlInstanceRespBody, err := utils.FlattenResponse(getMysqlInstanceResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the read weights are only available when the proxy is enabled
+	proxyDetail, _ := getProxyDetail(getMysqlInstanceClient, instanceID)
+
+	dataSourceId, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(dataSourceId)
+
+	nodes, err := flattenGaussDBMysqlNodes(d, getMysqlInstanceClient, getMysqlInstanceRespBody, proxyDetail)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("nodes", nodes),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// getMysqlNodeReplicationDelay queries the replication delay of the read-only node, the master node has no delay.
+func getMysqlNodeReplicationDelay(client *golangsdk.ServiceClient, instanceID, nodeID string) (interface{}, error) {
+	getNodePath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/nodes/{node_id}"
+	getNodePath = strings.ReplaceAll(getNodePath, "{project_id}", client.ProjectID)
+	getNodePath = strings.ReplaceAll(getNodePath, "{instance_id}", instanceID)
+	getNodePath = strings.ReplaceAll(getNodePath, "{node_id}", nodeID)
+
+	getNodeOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getNodeResp, err := client.Request("GET", getNodePath, &getNodeOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving node (%s) of GaussDB MySQL instance (%s): %s", nodeID, instanceID, err)
+	}
+
+	getNodeRespBody, err := utils.FlattenResponse(getNodeResp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("node.replication_delay", getNodeRespBody, nil), nil
+}
+
+func flattenGaussDBMysqlNodes(d *schema.ResourceData, client *golangsdk.ServiceClient, instance,
+	proxyDetail interface{}) ([]interface{}, error) {
+	instanceID := d.Get("instance_id").(string)
+	nodes := utils.PathSearch("instance.nodes", instance, make([]interface{}, 0)).([]interface{})
+	rst := make([]interface{}, 0, len(nodes))
+	for _, v := range nodes {
+		nodeType := utils.PathSearch("type", v, "").(string)
+		if filterType, ok := d.GetOk("type"); ok && filterType.(string) != nodeType {
+			continue
+		}
+
+		id := utils.PathSearch("id", v, "").(string)
+		weight := utils.PathSearch("master_node.weight", proxyDetail, nil)
+		var replicationDelay interface{}
+		if nodeType != "master" {
+			weight = utils.PathSearch(fmt.Sprintf("readonly_nodes[?id=='%s']|[0].weight", id), proxyDetail, nil)
+
+			var err error
+			replicationDelay, err = getMysqlNodeReplicationDelay(client, instanceID, id)
+			if err != nil {
+				return nil, err
+			}
+		}
+		rst = append(rst, map[string]interface{}{
+			"id":                id,
+			"name":              utils.PathSearch("name", v, nil),
+			"type":              nodeType,
+			"status":            utils.PathSearch("status", v, nil),
+			"priority":          utils.PathSearch("priority", v, nil),
+			"flavor":            utils.PathSearch("flavor_ref", v, nil),
+			"availability_zone": utils.PathSearch("az_code", v, nil),
+			"private_read_ip":   utils.PathSearch("private_read_ips|[0]", v, nil),
+			"weight":            weight,
+			"replication_delay": replicationDelay,
+		})
+	}
+	return rst, nil
+}
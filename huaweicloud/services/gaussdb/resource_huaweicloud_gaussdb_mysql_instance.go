@@ -2,6 +2,7 @@ package gaussdb
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/chnsz/golangsdk/openstack/taurusdb/v3/configurations"
 	"github.com/chnsz/golangsdk/openstack/taurusdb/v3/instances"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,10 +28,10 @@ import (
 
 func ResourceGaussDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGaussDBInstanceCreate,
-		Update: resourceGaussDBInstanceUpdate,
-		Read:   resourceGaussDBInstanceRead,
-		Delete: resourceGaussDBInstanceDelete,
+		Create:        resourceGaussDBInstanceCreate,
+		UpdateContext: resourceGaussDBInstanceUpdate,
+		Read:          resourceGaussDBInstanceRead,
+		Delete:        resourceGaussDBInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -90,7 +92,15 @@ func ResourceGaussDBInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+			},
+			"restart_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"restart_in_maintenance_window": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"restart_on_parameter_change"},
 			},
 			"configuration_name": {
 				Type:     schema.TypeString,
@@ -120,9 +130,10 @@ func ResourceGaussDBInstance() *schema.Resource {
 				Optional: true,
 			},
 			"read_replicas": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  1,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				DiffSuppressFunc: suppressAutoScaledReadReplicas,
 			},
 			"volume_size": {
 				Type:         schema.TypeInt,
@@ -311,6 +322,76 @@ func ResourceGaussDBInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"auto_scaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ON", "OFF",
+							}, false),
+						},
+						"flavor_switch": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ON", "OFF",
+							}, false),
+						},
+						"read_only_switch": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ON", "OFF",
+							}, false),
+						},
+						"monitor_cycle": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"silence_cycle": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"enlarge_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(50, 100),
+						},
+						"max_flavor": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"reduce_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"max_read_only_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"read_only_weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -564,6 +645,12 @@ func resourceGaussDBInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if _, ok := d.GetOk("auto_scaling"); ok {
+		if err = updateAutoScaling(d, client, id); err != nil {
+			return err
+		}
+	}
+
 	// set tags
 	tagRaw := d.Get("tags").(map[string]interface{})
 	if len(tagRaw) > 0 {
@@ -733,6 +820,13 @@ func resourceGaussDBInstanceRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("audit_log_enabled", status)
 	}
 
+	// set auto scaling policy
+	if policy, err := getAutoScaling(client, instanceID); err != nil {
+		logp.Printf("[DEBUG] query Instance %s auto scaling policy failed: %s", instanceID, err)
+	} else {
+		d.Set("auto_scaling", flattenAutoScaling(policy))
+	}
+
 	// save tags
 	if resourceTags, err := tags.Get(client, "instances", d.Id()).Extract(); err == nil {
 		tagmap := utils.TagsToMap(resourceTags.Tags)
@@ -746,15 +840,38 @@ func resourceGaussDBInstanceRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceGaussDBInstanceUpdate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	pendingParams, err := updateGaussDBInstance(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = resourceGaussDBInstanceRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(pendingParams) > 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Parameters Changed",
+				Detail: fmt.Sprintf("Parameters %s changed which needs reboot, the changes are pending "+
+					"until the instance is rebooted.", pendingParams),
+			},
+		}
+	}
+	return nil
+}
+
+// updateGaussDBInstance updates the instance and returns the changed parameters which are pending until the instance
+// is rebooted.
+func updateGaussDBInstance(d *schema.ResourceData, meta interface{}) ([]string, error) {
 	config := meta.(*config.Config)
 	client, err := config.GaussdbV3Client(config.GetRegion(d))
 	if err != nil {
-		return fmtp.Errorf("error creating HuaweiCloud GaussDB client: %s ", err)
+		return nil, fmtp.Errorf("error creating HuaweiCloud GaussDB client: %s ", err)
 	}
 	bssClient, err := config.BssV2Client(config.GetRegion(d))
 	if err != nil {
-		return fmtp.Errorf("error creating HuaweiCloud bss V2 client: %s", err)
+		return nil, fmtp.Errorf("error creating HuaweiCloud bss V2 client: %s", err)
 	}
 
 	instanceId := d.Id()
@@ -768,11 +885,11 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 		n, err := instances.UpdateName(client, instanceId, updateNameOpts).ExtractJobResponse()
 		if err != nil {
-			return fmtp.Errorf("error updating name for instance %s: %s ", instanceId, err)
+			return nil, fmtp.Errorf("error updating name for instance %s: %s ", instanceId, err)
 		}
 
 		if err := instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), n.JobID); err != nil {
-			return err
+			return nil, err
 		}
 		logp.Printf("[DEBUG] Updated Name to %s for instance %s", newName, instanceId)
 	}
@@ -785,7 +902,7 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 		_, err := instances.UpdatePass(client, instanceId, updatePassOpts).ExtractJobResponse()
 		if err != nil {
-			return fmtp.Errorf("error updating password for instance %s: %s ", instanceId, err)
+			return nil, fmtp.Errorf("error updating password for instance %s: %s ", instanceId, err)
 		}
 		logp.Printf("[DEBUG] Updated Password for instance %s", instanceId)
 	}
@@ -804,24 +921,24 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 		n, err := instances.Resize(client, instanceId, resizeOpts).ExtractJobResponse()
 		if err != nil {
-			return fmtp.Errorf("error updating flavor for instance %s: %s ", instanceId, err)
+			return nil, fmtp.Errorf("error updating flavor for instance %s: %s ", instanceId, err)
 		}
 
 		// wait for job success
 		if n.JobID != "" {
 			if err := instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), n.JobID); err != nil {
-				return err
+				return nil, err
 			}
 		}
 		// wait for order success
 		if n.OrderID != "" {
 			if err := orders.WaitForOrderSuccess(bssClient, int(d.Timeout(schema.TimeoutUpdate)/time.Second), n.OrderID); err != nil {
-				return err
+				return nil, err
 			}
 			// check whether the order take effect
 			instance, err := instances.Get(client, instanceId).Extract()
 			if err != nil {
-				return err
+				return nil, err
 			}
 			currFlavor := ""
 			for _, raw := range instance.Nodes {
@@ -831,7 +948,7 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 				}
 			}
 			if currFlavor != newFlavor {
-				return fmtp.Errorf("error updating flavor for instance %s: order failed", instanceId)
+				return nil, fmtp.Errorf("error updating flavor for instance %s: order failed", instanceId)
 			}
 		}
 		logp.Printf("[DEBUG] Updated Flavor for instance %s", instanceId)
@@ -855,7 +972,7 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 			n, err := instances.CreateReplica(client, instanceId, createReplicaOpts).ExtractJobResponse()
 			if err != nil {
-				return fmtp.Errorf("error creating read replicas for instance %s: %s ", instanceId, err)
+				return nil, fmtp.Errorf("error creating read replicas for instance %s: %s ", instanceId, err)
 			}
 
 			// wait for job success
@@ -866,19 +983,19 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 					job_id := job_list[i]
 					logp.Printf("[DEBUG] Waiting for job: %s", job_id)
 					if err := instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), job_id); err != nil {
-						return err
+						return nil, err
 					}
 				}
 			}
 			// wait for order success
 			if n.OrderID != "" {
 				if err := orders.WaitForOrderSuccess(bssClient, int(d.Timeout(schema.TimeoutUpdate)/time.Second), n.OrderID); err != nil {
-					return err
+					return nil, err
 				}
 				// check whether the order take effect
 				instance, err := instances.Get(client, instanceId).Extract()
 				if err != nil {
-					return err
+					return nil, err
 				}
 				slave_count := 0
 				for _, raw := range instance.Nodes {
@@ -887,7 +1004,7 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 					}
 				}
 				if newnum.(int) != slave_count {
-					return fmtp.Errorf("error updating read_replicas for instance %s: order failed", instanceId)
+					return nil, fmtp.Errorf("error updating read_replicas for instance %s: order failed", instanceId)
 				}
 			}
 		}
@@ -904,16 +1021,17 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 			}
 			logp.Printf("[DEBUG] Slave Nodes: %+v", slave_nodes)
 			if len(slave_nodes) <= shrink_size {
-				return fmtp.Errorf("error deleting read replicas for instance %s: Shrink Size is bigger than active slave nodes", instanceId)
+				return nil, fmtp.Errorf("error deleting read replicas for instance %s: Shrink Size is bigger than "+
+					"active slave nodes", instanceId)
 			}
 			for i := 0; i < shrink_size; i++ {
 				n, err := instances.DeleteReplica(client, instanceId, slave_nodes[i]).ExtractJobResponse()
 				if err != nil {
-					return fmtp.Errorf("error creating read replica %s for instance %s: %s ", slave_nodes[i], instanceId, err)
+					return nil, fmtp.Errorf("error creating read replica %s for instance %s: %s ", slave_nodes[i], instanceId, err)
 				}
 
 				if err := instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), n.JobID); err != nil {
-					return err
+					return nil, err
 				}
 				logp.Printf("[DEBUG] Deleted Read Replica: %s", slave_nodes[i])
 			}
@@ -929,18 +1047,18 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 		n, err := instances.ExtendVolume(client, d.Id(), extendOpts).ExtractJobResponse()
 		if err != nil {
-			return fmtp.Errorf("error extending volume: %s", err)
+			return nil, fmtp.Errorf("error extending volume: %s", err)
 		}
 
 		// wait for order success
 		if n.OrderID != "" {
 			if err := orders.WaitForOrderSuccess(bssClient, int(d.Timeout(schema.TimeoutUpdate)/time.Second), n.OrderID); err != nil {
-				return err
+				return nil, err
 			}
 			// check whether the order take effect
 			instance, err := instances.Get(client, instanceId).Extract()
 			if err != nil {
-				return err
+				return nil, err
 			}
 			volume_size := 0
 			for _, raw := range instance.Nodes {
@@ -950,7 +1068,7 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 				}
 			}
 			if volume_size != d.Get("volume_size").(int) {
-				return fmtp.Errorf("error updating volume for instance %s: order failed", instanceId)
+				return nil, fmtp.Errorf("error updating volume for instance %s: order failed", instanceId)
 			}
 		}
 	}
@@ -968,7 +1086,7 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 		err = backups.Update(client, d.Id(), updateOpts).ExtractErr()
 		if err != nil {
-			return fmtp.Errorf("error updating backup_strategy: %s", err)
+			return nil, fmtp.Errorf("error updating backup_strategy: %s", err)
 		}
 	}
 
@@ -982,20 +1100,20 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 			ep, err := instances.EnableProxy(client, d.Id(), proxyOpts).ExtractJobResponse()
 			if err != nil {
-				return fmtp.Errorf("error enabling proxy: %s", err)
+				return nil, fmtp.Errorf("error enabling proxy: %s", err)
 			}
 
 			if err = instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), ep.JobID); err != nil {
-				return err
+				return nil, err
 			}
 		} else {
 			dp, err := instances.DeleteProxy(client, d.Id()).ExtractJobResponse()
 			if err != nil {
-				return fmtp.Errorf("error disabling proxy: %s", err)
+				return nil, fmtp.Errorf("error disabling proxy: %s", err)
 			}
 
 			if err = instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), dp.JobID); err != nil {
-				return err
+				return nil, err
 			}
 		}
 	}
@@ -1011,22 +1129,36 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 
 			lp, err := instances.EnlargeProxy(client, d.Id(), enlargeProxyOpts).ExtractJobResponse()
 			if err != nil {
-				return fmtp.Errorf("error enlarging proxy: %s", err)
+				return nil, fmtp.Errorf("error enlarging proxy: %s", err)
 			}
 
 			if err = instances.WaitForJobSuccess(client, int(d.Timeout(schema.TimeoutUpdate)/time.Second), lp.JobID); err != nil {
-				return err
+				return nil, err
 			}
 		}
 		if newnum.(int) < oldnum.(int) && !d.HasChange("proxy_flavor") {
-			return fmtp.Errorf("error updating proxy_node_num for instance %s: new num should be greater than old num", d.Id())
+			return nil, fmtp.Errorf("error updating proxy_node_num for instance %s: new num should be greater "+
+				"than old num", d.Id())
+		}
+	}
+
+	var pendingParams []string
+	if d.HasChange("configuration_id") {
+		if pendingParams, err = applyConfiguration(d, client, instanceId); err != nil {
+			return nil, err
 		}
 	}
 
 	if d.HasChange("audit_log_enabled") {
 		err = switchAuditLog(client, instanceId, d.Get("audit_log_enabled").(bool))
 		if err != nil {
-			return err
+			return nil, err
+		}
+	}
+
+	if d.HasChange("auto_scaling") {
+		if err = updateAutoScaling(d, client, instanceId); err != nil {
+			return nil, err
 		}
 	}
 
@@ -1034,21 +1166,21 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 	if d.HasChange("tags") {
 		tagErr := utils.UpdateResourceTags(client, d, "instances", d.Id())
 		if tagErr != nil {
-			return fmtp.Errorf("error updating tags of Gaussdb mysql instance %q: %s", d.Id(), tagErr)
+			return nil, fmtp.Errorf("error updating tags of Gaussdb mysql instance %q: %s", d.Id(), tagErr)
 		}
 	}
 
 	if d.HasChange("auto_renew") {
 		bssClient, err := config.BssV2Client(config.GetRegion(d))
 		if err != nil {
-			return fmtp.Errorf("error creating BSS V2 client: %s", err)
+			return nil, fmtp.Errorf("error creating BSS V2 client: %s", err)
 		}
 		if err = common.UpdateAutoRenew(bssClient, d.Get("auto_renew").(string), d.Id()); err != nil {
-			return fmtp.Errorf("error updating the auto-renew of the instance (%s): %s", d.Id(), err)
+			return nil, fmtp.Errorf("error updating the auto-renew of the instance (%s): %s", d.Id(), err)
 		}
 	}
 
-	return resourceGaussDBInstanceRead(d, meta)
+	return pendingParams, nil
 }
 
 func resourceGaussDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -1111,3 +1243,184 @@ func switchAuditLog(client *golangsdk.ServiceClient, instanceId string, v bool)
 
 	return nil
 }
+
+// getConfigurationParameters returns the parameter values of the parameter template.
+func getConfigurationParameters(client *golangsdk.ServiceClient, configurationId string) ([]interface{}, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("configurations", configurationId), &r.Body,
+		&golangsdk.RequestOpts{
+			MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		})
+	if r.Err != nil {
+		return nil, r.Err
+	}
+	return utils.PathSearch("parameter_values", r.Body, make([]interface{}, 0)).([]interface{}), nil
+}
+
+// getRestartRequiredParameters returns the parameters which need a reboot and are changed by switching the parameter
+// template from oldConfigurationId to newConfigurationId. All the parameters of the new template which need a reboot
+// are returned if the old template is unknown.
+func getRestartRequiredParameters(client *golangsdk.ServiceClient, oldConfigurationId,
+	newConfigurationId string) ([]string, error) {
+	newParams, err := getConfigurationParameters(client, newConfigurationId)
+	if err != nil {
+		return nil, fmtp.Errorf("error retrieving the parameters of configuration %s: %s", newConfigurationId, err)
+	}
+
+	oldValues := make(map[string]interface{})
+	if oldConfigurationId != "" {
+		oldParams, err := getConfigurationParameters(client, oldConfigurationId)
+		if err != nil {
+			logp.Printf("[WARN] unable to retrieve the parameters of configuration %s: %s", oldConfigurationId, err)
+		}
+		for _, param := range oldParams {
+			oldValues[utils.PathSearch("name", param, "").(string)] = utils.PathSearch("value", param, nil)
+		}
+	}
+
+	var restart []string
+	for _, param := range newParams {
+		if !utils.PathSearch("restart_required", param, false).(bool) {
+			continue
+		}
+		name := utils.PathSearch("name", param, "").(string)
+		if oldValue, ok := oldValues[name]; ok && oldValue == utils.PathSearch("value", param, nil) {
+			continue
+		}
+		restart = append(restart, name)
+	}
+	return restart, nil
+}
+
+// applyConfiguration applies the parameter template to the instance and returns the changed parameters which are
+// pending until the instance is rebooted. The instance is rebooted only if some changed parameters need a reboot and
+// restart_on_parameter_change is enabled.
+func applyConfiguration(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceId string) ([]string, error) {
+	oldRaw, newRaw := d.GetChange("configuration_id")
+	configurationId := newRaw.(string)
+	restartParams, err := getRestartRequiredParameters(client, oldRaw.(string), configurationId)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := int(d.Timeout(schema.TimeoutUpdate) / time.Second)
+	applyOpts := map[string]interface{}{
+		"instance_ids": []string{instanceId},
+	}
+	logp.Printf("[DEBUG] Apply configuration %s options: %+v", configurationId, applyOpts)
+
+	var r instances.JobResult
+	_, r.Err = client.Put(client.ServiceURL("configurations", configurationId, "apply"), applyOpts, &r.Body,
+		&golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+	n, err := r.ExtractJobResponse()
+	if err != nil {
+		return nil, fmtp.Errorf("error applying configuration %s to instance %s: %s", configurationId, instanceId, err)
+	}
+	if err = instances.WaitForJobSuccess(client, timeout, n.JobID); err != nil {
+		return nil, err
+	}
+
+	if len(restartParams) == 0 {
+		return nil, nil
+	}
+	if !d.Get("restart_on_parameter_change").(bool) {
+		return restartParams, nil
+	}
+
+	delay := d.Get("restart_in_maintenance_window").(bool)
+	restartOpts := map[string]interface{}{
+		"delay": delay,
+	}
+	var restart instances.JobResult
+	_, restart.Err = client.Post(client.ServiceURL("instances", instanceId, "restart"), restartOpts, &restart.Body,
+		&golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+	job, err := restart.ExtractJobResponse()
+	if err != nil {
+		return nil, fmtp.Errorf("error rebooting instance %s: %s", instanceId, err)
+	}
+	if delay {
+		logp.Printf("[DEBUG] instance %s will be rebooted during the maintenance window", instanceId)
+		return nil, nil
+	}
+	return nil, instances.WaitForJobSuccess(client, timeout, job.JobID)
+}
+
+func getAutoScaling(client *golangsdk.ServiceClient, instanceId string) (interface{}, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceId, "auto-scaling", "policy"), &r.Body,
+		&golangsdk.RequestOpts{
+			MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		})
+	return r.Body, r.Err
+}
+
+// suppressAutoScaledReadReplicas ignores the read replicas added by the auto scaling, otherwise they are deleted in
+// the next apply.
+func suppressAutoScaledReadReplicas(_, oldValue, newValue string, d *schema.ResourceData) bool {
+	if d.Get("auto_scaling.0.status").(string) != "ON" || d.Get("auto_scaling.0.read_only_switch").(string) != "ON" {
+		return false
+	}
+
+	oldNum, _ := strconv.Atoi(oldValue)
+	newNum, _ := strconv.Atoi(newValue)
+	return oldNum > newNum
+}
+
+func flattenAutoScaling(policy interface{}) []map[string]interface{} {
+	status := utils.PathSearch("status", policy, "").(string)
+	if status == "" {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"status":              status,
+			"flavor_switch":       utils.PathSearch("scaling_strategy.flavor_switch", policy, nil),
+			"read_only_switch":    utils.PathSearch("scaling_strategy.read_only_switch", policy, nil),
+			"monitor_cycle":       utils.PathSearch("monitor_cycle", policy, nil),
+			"silence_cycle":       utils.PathSearch("silence_cycle", policy, nil),
+			"enlarge_threshold":   utils.PathSearch("enlarge_threshold", policy, nil),
+			"max_flavor":          utils.PathSearch("max_flavor", policy, nil),
+			"reduce_enabled":      utils.PathSearch("reduce_enabled", policy, nil),
+			"max_read_only_count": utils.PathSearch("max_read_only_count", policy, nil),
+			"read_only_weight":    utils.PathSearch("read_only_weight", policy, nil),
+		},
+	}
+}
+
+// updateAutoScaling updates the auto scaling policy of the instance, which scales up the flavor and adds the read
+// replicas automatically when the CPU usage reaches the threshold.
+func updateAutoScaling(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceId string) error {
+	rawPolicies := d.Get("auto_scaling").([]interface{})
+	if len(rawPolicies) == 0 || rawPolicies[0] == nil {
+		return nil
+	}
+	rawPolicy := rawPolicies[0].(map[string]interface{})
+	policyOpts := map[string]interface{}{
+		"status":              rawPolicy["status"],
+		"monitor_cycle":       utils.ValueIngoreEmpty(rawPolicy["monitor_cycle"]),
+		"silence_cycle":       utils.ValueIngoreEmpty(rawPolicy["silence_cycle"]),
+		"enlarge_threshold":   utils.ValueIngoreEmpty(rawPolicy["enlarge_threshold"]),
+		"max_flavor":          utils.ValueIngoreEmpty(rawPolicy["max_flavor"]),
+		"reduce_enabled":      rawPolicy["reduce_enabled"],
+		"max_read_only_count": utils.ValueIngoreEmpty(rawPolicy["max_read_only_count"]),
+		"read_only_weight":    utils.ValueIngoreEmpty(rawPolicy["read_only_weight"]),
+		"scaling_strategy": map[string]interface{}{
+			"flavor_switch":    utils.ValueIngoreEmpty(rawPolicy["flavor_switch"]),
+			"read_only_switch": utils.ValueIngoreEmpty(rawPolicy["read_only_switch"]),
+		},
+	}
+	logp.Printf("[DEBUG] Update auto scaling policy of instance %s: %#v", instanceId, policyOpts)
+
+	_, err := client.Put(client.ServiceURL("instances", instanceId, "auto-scaling", "policy"),
+		utils.RemoveNil(policyOpts), nil, &golangsdk.RequestOpts{
+			OkCodes: []int{200, 202},
+		})
+	if err != nil {
+		return fmtp.Errorf("error updating auto scaling policy for instance %s: %s", instanceId, err)
+	}
+	return nil
+}
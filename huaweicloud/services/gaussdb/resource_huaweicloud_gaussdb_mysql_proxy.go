@@ -4,12 +4,18 @@ import (
 	"context"
 	"time"
 
+	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/taurusdb/v3/instances"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/logp"
 )
 
 func ResourceGaussDBProxy() *schema.Resource {
@@ -49,6 +55,56 @@ func ResourceGaussDBProxy() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			"route_mode": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1, 2}),
+			},
+			"master_node_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"readonly_nodes_weight": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"consistence_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"session", "global", "eventual",
+				}, false),
+			},
+			"transaction_split": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ON", "OFF",
+				}, false),
+			},
+			"proxy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,11 +140,65 @@ func resourceGaussDBProxyCreate(ctx context.Context, d *schema.ResourceData, met
 		return fmtp.DiagErrorf("Error waiting for gaussdb_mysql_proxy job: %s", err)
 	}
 
+	if err = updateProxyRules(d, client, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceGaussDBProxyRead(ctx, d, meta)
 }
 
+// getProxyDetail queries the proxy of the instance, the response contains the proxy and the weights of the nodes.
+func getProxyDetail(client *golangsdk.ServiceClient, instanceId string) (interface{}, error) {
+	var r golangsdk.Result
+	_, r.Err = client.Get(client.ServiceURL("instances", instanceId, "proxy"), &r.Body, &golangsdk.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	})
+	return r.Body, r.Err
+}
+
 func resourceGaussDBProxyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return nil
+	config := meta.(*config.Config)
+	region := config.GetRegion(d)
+	client, err := config.GaussdbV3Client(region)
+	if err != nil {
+		return fmtp.DiagErrorf("Error creating HuaweiCloud GaussDB client: %s ", err)
+	}
+
+	detail, err := getProxyDetail(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving gaussdb_mysql_proxy")
+	}
+	if utils.PathSearch("proxy.pool_id", detail, "").(string) == "" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving gaussdb_mysql_proxy")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", d.Id()),
+		d.Set("proxy_id", utils.PathSearch("proxy.pool_id", detail, nil)),
+		d.Set("flavor", utils.PathSearch("proxy.flavor_ref", detail, nil)),
+		d.Set("node_num", utils.PathSearch("proxy.node_num", detail, nil)),
+		d.Set("address", utils.PathSearch("proxy.address", detail, nil)),
+		d.Set("port", utils.PathSearch("proxy.port", detail, nil)),
+		d.Set("route_mode", utils.PathSearch("proxy.route_mode", detail, nil)),
+		d.Set("consistence_mode", utils.PathSearch("proxy.consistence_mode", detail, nil)),
+		d.Set("transaction_split", utils.PathSearch("proxy.transaction_split", detail, nil)),
+		d.Set("master_node_weight", utils.PathSearch("master_node.weight", detail, nil)),
+		d.Set("readonly_nodes_weight", flattenProxyReadonlyNodesWeight(detail)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenProxyReadonlyNodesWeight(detail interface{}) []map[string]interface{} {
+	nodes := utils.PathSearch("readonly_nodes", detail, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, map[string]interface{}{
+			"id":     utils.PathSearch("id", node, nil),
+			"weight": utils.PathSearch("weight", node, nil),
+		})
+	}
+	return result
 }
 
 func resourceGaussDBProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -119,9 +229,104 @@ func resourceGaussDBProxyUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if err = updateProxyRules(d, client, schema.TimeoutUpdate); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceGaussDBProxyRead(ctx, d, meta)
 }
 
+// updateProxyRules updates the read weights, the route mode, the consistency mode and the transaction splitting of
+// the proxy. Only the changed arguments are updated, and the arguments which are not set are not updated on creation.
+func updateProxyRules(d *schema.ResourceData, client *golangsdk.ServiceClient, timeoutKey string) error {
+	if !d.HasChanges("route_mode", "master_node_weight", "readonly_nodes_weight", "consistence_mode",
+		"transaction_split") {
+		return nil
+	}
+
+	instanceId := d.Id()
+	detail, err := getProxyDetail(client, instanceId)
+	if err != nil {
+		return fmtp.Errorf("error retrieving gaussdb_mysql_proxy: %s", err)
+	}
+	proxyId := utils.PathSearch("proxy.pool_id", detail, "").(string)
+	timeout := int(d.Timeout(timeoutKey) / time.Second)
+
+	if d.HasChanges("route_mode", "master_node_weight", "readonly_nodes_weight") {
+		weightOpts := map[string]interface{}{
+			"master_weight":  d.Get("master_node_weight"),
+			"readonly_nodes": buildProxyReadonlyNodesWeight(d),
+		}
+		action := "weight"
+		if d.HasChange("route_mode") {
+			// the weights are required to be specified together with the route mode
+			weightOpts["route_mode"] = d.Get("route_mode")
+			action = "route-mode"
+		}
+		logp.Printf("[DEBUG] Update proxy %s %s options: %#v", proxyId, action, weightOpts)
+		if err = doProxyJobRequest(client, "PUT",
+			client.ServiceURL("instances", instanceId, "proxy", proxyId, action), weightOpts, timeout); err != nil {
+			return fmtp.Errorf("error updating the %s of gaussdb_mysql_proxy %s: %s", action, instanceId, err)
+		}
+	}
+
+	if d.HasChange("consistence_mode") {
+		consistenceOpts := map[string]interface{}{
+			"consistence_mode": d.Get("consistence_mode"),
+		}
+		if err = doProxyJobRequest(client, "PUT",
+			client.ServiceURL("instances", instanceId, "proxy", proxyId, "session-consistence"),
+			consistenceOpts, timeout); err != nil {
+			return fmtp.Errorf("error updating the consistence mode of gaussdb_mysql_proxy %s: %s", instanceId, err)
+		}
+	}
+
+	if d.HasChange("transaction_split") {
+		splitOpts := map[string]interface{}{
+			"transaction_split": d.Get("transaction_split"),
+			"proxy_id_list":     []string{proxyId},
+		}
+		if err = doProxyJobRequest(client, "POST",
+			client.ServiceURL("instances", instanceId, "proxy", "transaction-split"), splitOpts, timeout); err != nil {
+			return fmtp.Errorf("error updating the transaction split of gaussdb_mysql_proxy %s: %s", instanceId, err)
+		}
+	}
+	return nil
+}
+
+func buildProxyReadonlyNodesWeight(d *schema.ResourceData) []map[string]interface{} {
+	rawNodes := d.Get("readonly_nodes_weight").(*schema.Set).List()
+	nodes := make([]map[string]interface{}, 0, len(rawNodes))
+	for _, raw := range rawNodes {
+		node := raw.(map[string]interface{})
+		nodes = append(nodes, map[string]interface{}{
+			"id":     node["id"],
+			"weight": node["weight"],
+		})
+	}
+	return nodes
+}
+
+// doProxyJobRequest sends the request of the proxy and waits for the job if a job ID is returned.
+func doProxyJobRequest(client *golangsdk.ServiceClient, method, url string, opts map[string]interface{},
+	timeout int) error {
+	var r golangsdk.Result
+	_, r.Err = client.Request(method, url, &golangsdk.RequestOpts{
+		JSONBody:     opts,
+		JSONResponse: &r.Body,
+		OkCodes:      []int{200, 202},
+	})
+	if r.Err != nil {
+		return r.Err
+	}
+
+	jobId := utils.PathSearch("job_id", r.Body, "").(string)
+	if jobId == "" {
+		return nil
+	}
+	return instances.WaitForJobSuccess(client, timeout, jobId)
+}
+
 func resourceGaussDBProxyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	client, err := config.GaussdbV3Client(config.GetRegion(d))
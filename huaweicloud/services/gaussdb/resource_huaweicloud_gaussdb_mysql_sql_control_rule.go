@@ -0,0 +1,259 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceGaussDBSqlControlRule is the impl of huaweicloud_gaussdb_mysql_sql_control_rule resource, which limits the
+// concurrency of the SQL statements matching the pattern on a node.
+// The ID is formatted <instance_id>/<node_id>/<sql_type>/<pattern>.
+func ResourceGaussDBSqlControlRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBSqlControlRuleCreate,
+		ReadContext:   resourceGaussDBSqlControlRuleRead,
+		UpdateContext: resourceGaussDBSqlControlRuleUpdate,
+		DeleteContext: resourceGaussDBSqlControlRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGaussDBSqlControlRuleImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the GaussDB MySQL instance.`,
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the node on which the rule takes effect.`,
+			},
+			"sql_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SELECT", "UPDATE", "DELETE"}, false),
+				Description:  `Specifies the type of the SQL statements.`,
+			},
+			"pattern": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the pattern of the SQL statements to be limited.`,
+			},
+			"max_concurrency": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: `Specifies the maximum number of the concurrent SQL statements matching the pattern.`,
+			},
+		},
+	}
+}
+
+func buildSqlControlRuleBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"node_id": d.Get("node_id"),
+		"rules": []map[string]interface{}{
+			{
+				"sql_type": d.Get("sql_type"),
+				"patterns": []map[string]interface{}{
+					{
+						"pattern":         d.Get("pattern"),
+						"max_concurrency": d.Get("max_concurrency"),
+					},
+				},
+			},
+		},
+	}
+	return bodyParams
+}
+
+// setSqlControlRule creates or updates the SQL control rule, the API overwrites the rule with the same pattern.
+func setSqlControlRule(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	setSqlControlRuleHttpUrl := "v3/{project_id}/instances/{instance_id}/sql-filter/rules"
+	setSqlControlRulePath := client.Endpoint + setSqlControlRuleHttpUrl
+	setSqlControlRulePath = strings.ReplaceAll(setSqlControlRulePath, "{project_id}", client.ProjectID)
+	setSqlControlRulePath = strings.ReplaceAll(setSqlControlRulePath, "{instance_id}",
+		d.Get("instance_id").(string))
+
+	setSqlControlRuleOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202,
+		},
+		JSONBody: buildSqlControlRuleBodyParams(d),
+	}
+	_, err := client.Request("PUT", setSqlControlRulePath, &setSqlControlRuleOpt)
+	return err
+}
+
+func resourceGaussDBSqlControlRuleCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GaussdbV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB client: %s", err)
+	}
+
+	if err = setSqlControlRule(client, d); err != nil {
+		return diag.Errorf("error creating GaussDB MySQL SQL control rule: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", d.Get("instance_id"), d.Get("node_id"), d.Get("sql_type"),
+		d.Get("pattern")))
+
+	return resourceGaussDBSqlControlRuleRead(ctx, d, meta)
+}
+
+func resourceGaussDBSqlControlRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// getSqlControlRule: query GaussDB MySQL SQL control rule
+	var (
+		getSqlControlRuleHttpUrl = "v3/{project_id}/instances/{instance_id}/sql-filter/rules?node_id={node_id}" +
+			"&sql_type={sql_type}"
+		getSqlControlRuleProduct = "gaussdb"
+	)
+	getSqlControlRuleClient, err := cfg.NewServiceClient(getSqlControlRuleProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB client: %s", err)
+	}
+
+	getSqlControlRulePath := getSqlControlRuleClient.Endpoint + getSqlControlRuleHttpUrl
+	getSqlControlRulePath = strings.ReplaceAll(getSqlControlRulePath, "{project_id}",
+		getSqlControlRuleClient.ProjectID)
+	getSqlControlRulePath = strings.ReplaceAll(getSqlControlRulePath, "{instance_id}",
+		d.Get("instance_id").(string))
+	getSqlControlRulePath = strings.ReplaceAll(getSqlControlRulePath, "{node_id}", d.Get("node_id").(string))
+	getSqlControlRulePath = strings.ReplaceAll(getSqlControlRulePath, "{sql_type}", d.Get("sql_type").(string))
+
+	getSqlControlRuleOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getSqlControlRuleResp, err := getSqlControlRuleClient.Request("GET", getSqlControlRulePath,
+		&getSqlControlRuleOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GaussDB MySQL SQL control rule")
+	}
+
+	getSqlControlRuleRespBody, err := utils.FlattenResponse(getSqlControlRuleResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expression := fmt.Sprintf("sql_filter_rules[?sql_type=='%s']|[0].patterns[?pattern=='%s']|[0]",
+		d.Get("sql_type"), d.Get("pattern"))
+	rule := utils.PathSearch(expression, getSqlControlRuleRespBody, nil)
+	if rule == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving GaussDB MySQL SQL control rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("max_concurrency", utils.PathSearch("max_concurrency", rule, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceGaussDBSqlControlRuleUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.GaussdbV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating GaussDB client: %s", err)
+	}
+
+	if err = setSqlControlRule(client, d); err != nil {
+		return diag.Errorf("error updating GaussDB MySQL SQL control rule: %s", err)
+	}
+
+	return resourceGaussDBSqlControlRuleRead(ctx, d, meta)
+}
+
+func resourceGaussDBSqlControlRuleDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteSqlControlRule: delete GaussDB MySQL SQL control rule
+	var (
+		deleteSqlControlRuleHttpUrl = "v3/{project_id}/instances/{instance_id}/sql-filter/rules"
+		deleteSqlControlRuleProduct = "gaussdb"
+	)
+	deleteSqlControlRuleClient, err := cfg.NewServiceClient(deleteSqlControlRuleProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB client: %s", err)
+	}
+
+	deleteSqlControlRulePath := deleteSqlControlRuleClient.Endpoint + deleteSqlControlRuleHttpUrl
+	deleteSqlControlRulePath = strings.ReplaceAll(deleteSqlControlRulePath, "{project_id}",
+		deleteSqlControlRuleClient.ProjectID)
+	deleteSqlControlRulePath = strings.ReplaceAll(deleteSqlControlRulePath, "{instance_id}",
+		d.Get("instance_id").(string))
+
+	deleteSqlControlRuleOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202, 204,
+		},
+		JSONBody: map[string]interface{}{
+			"node_id": d.Get("node_id"),
+			"rules": []map[string]interface{}{
+				{
+					"sql_type": d.Get("sql_type"),
+					"patterns": []string{d.Get("pattern").(string)},
+				},
+			},
+		},
+	}
+	_, err = deleteSqlControlRuleClient.Request("DELETE", deleteSqlControlRulePath, &deleteSqlControlRuleOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GaussDB MySQL SQL control rule")
+	}
+
+	return nil
+}
+
+// resourceGaussDBSqlControlRuleImportState imports the rule by <instance_id>/<node_id>/<sql_type>/<pattern>, the
+// pattern may contain slashes.
+func resourceGaussDBSqlControlRuleImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 4)
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("invalid id format, must be <instance_id>/<node_id>/<sql_type>/<pattern>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("node_id", parts[1]),
+		d.Set("sql_type", parts[2]),
+		d.Set("pattern", parts[3]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
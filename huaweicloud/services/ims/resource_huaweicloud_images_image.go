@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/imageservice/v2/images"
@@ -19,6 +20,7 @@ import (
 
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 )
 
 func ResourceImsImage() *schema.Resource {
@@ -63,7 +65,26 @@ func ResourceImsImage() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ExactlyOneOf: []string{"image_url"},
+				ExactlyOneOf: imageSourceKeys,
+			},
+			// vault_id is required for creating a whole image from an ECS
+			"vault_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"instance_id"},
+			},
+			// backup_id is required for creating a whole image from a CBR backup
+			"backup_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			// volume_id is required for creating a data disk image from an EVS volume
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
 			},
 			// image_url and min_disk are required for creating an image from an OBS
 			"image_url": {
@@ -76,7 +97,23 @@ func ResourceImsImage() *schema.Resource {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"instance_id"},
+				ConflictsWith: []string{"instance_id", "backup_id", "volume_id"},
+			},
+			// os_type is required for creating a data disk image from an OBS
+			"os_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				RequiredWith:  []string{"image_url"},
+				ConflictsWith: []string{"is_iso"},
+				ValidateFunc:  validation.StringInSlice([]string{"Linux", "Windows"}, false),
+			},
+			// is_iso is required for creating an ISO image from an OBS
+			"is_iso": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"image_url", "os_version"},
 			},
 			// following are valid for creating an image from an OBS
 			"os_version": {
@@ -149,6 +186,9 @@ func resourceContainerImageTags(d *schema.ResourceData) []cloudimages.ImageTag {
 	return tagList
 }
 
+// imageSourceKeys are the parameters which specify the source of the image, only one of them can be specified.
+var imageSourceKeys = []string{"instance_id", "backup_id", "volume_id", "image_url"}
+
 func resourceImsImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 	region := cfg.GetRegion(d)
@@ -158,6 +198,28 @@ func resourceImsImageCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("error creating IMS client: %s", err)
 	}
 
+	// The whole images, data disk images and ISO images are created by the IMS APIs which are not supported by the
+	// cloudimages package.
+	if isImsImageCreatedByJob(d) {
+		id, err := createImsImageByJob(ctx, d, cfg)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(id)
+
+		// The tags can not be specified when creating a data disk image, so set them after the image is created.
+		_, isDataImage := d.GetOk("volume_id")
+		if _, ok := d.GetOk("os_type"); ok {
+			isDataImage = true
+		}
+		if tagMap := d.Get("tags").(map[string]interface{}); len(tagMap) > 0 && isDataImage {
+			if err = setTagForImage(d, meta, id, tagMap); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return resourceImsImageRead(ctx, d, meta)
+	}
+
 	var v *cloudimages.JobResponse
 	imageTags := resourceContainerImageTags(d)
 	if val, ok := d.GetOk("instance_id"); ok {
@@ -217,6 +279,140 @@ func resourceImsImageCreate(ctx context.Context, d *schema.ResourceData, meta in
 	return diag.Errorf("unexpected conversion error in resourceImsImageCreate.")
 }
 
+func isImsImageCreatedByJob(d *schema.ResourceData) bool {
+	for _, key := range []string{"vault_id", "backup_id", "volume_id", "os_type", "is_iso"} {
+		if _, ok := d.GetOk(key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func buildImsImageTagsBodyParams(d *schema.ResourceData) []map[string]interface{} {
+	return utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{}))
+}
+
+func buildImsWholeImageBodyParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"name":                  d.Get("name"),
+		"description":           utils.ValueIngoreEmpty(d.Get("description")),
+		"instance_id":           utils.ValueIngoreEmpty(d.Get("instance_id")),
+		"vault_id":              utils.ValueIngoreEmpty(d.Get("vault_id")),
+		"backup_id":             utils.ValueIngoreEmpty(d.Get("backup_id")),
+		"max_ram":               utils.ValueIngoreEmpty(d.Get("max_ram")),
+		"min_ram":               utils.ValueIngoreEmpty(d.Get("min_ram")),
+		"image_tags":            buildImsImageTagsBodyParams(d),
+		"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+	}
+	if _, ok := d.GetOk("backup_id"); ok {
+		bodyParams["whole_image_type"] = "CBR"
+	}
+	return bodyParams
+}
+
+func buildImsDataImageByVolumeBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"data_images": []map[string]interface{}{
+			utils.RemoveNil(map[string]interface{}{
+				"name":        d.Get("name"),
+				"volume_id":   d.Get("volume_id"),
+				"description": utils.ValueIngoreEmpty(d.Get("description")),
+			}),
+		},
+	}
+}
+
+func buildImsImageByFileBodyParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                  d.Get("name"),
+		"description":           utils.ValueIngoreEmpty(d.Get("description")),
+		"image_url":             d.Get("image_url"),
+		"min_disk":              d.Get("min_disk"),
+		"os_type":               utils.ValueIngoreEmpty(d.Get("os_type")),
+		"os_version":            utils.ValueIngoreEmpty(d.Get("os_version")),
+		"is_config":             utils.ValueIngoreEmpty(d.Get("is_config")),
+		"cmk_id":                utils.ValueIngoreEmpty(d.Get("cmk_id")),
+		"type":                  utils.ValueIngoreEmpty(d.Get("type")),
+		"max_ram":               utils.ValueIngoreEmpty(d.Get("max_ram")),
+		"min_ram":               utils.ValueIngoreEmpty(d.Get("min_ram")),
+		"image_tags":            buildImsImageTagsBodyParams(d),
+		"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+	}
+}
+
+// createImsImageByJob creates a whole image, a data disk image or an ISO image, and returns the image ID after the
+// job is successful.
+func createImsImageByJob(ctx context.Context, d *schema.ResourceData, cfg *config.Config) (string, error) {
+	client, err := cfg.NewServiceClient("ims", cfg.GetRegion(d))
+	if err != nil {
+		return "", fmt.Errorf("error creating IMS client: %s", err)
+	}
+
+	var (
+		createHttpUrl string
+		bodyParams    map[string]interface{}
+	)
+	switch {
+	case d.Get("volume_id").(string) != "":
+		createHttpUrl = "v2/cloudimages/action"
+		bodyParams = buildImsDataImageByVolumeBodyParams(d)
+	case d.Get("os_type").(string) != "":
+		createHttpUrl = "v1/cloudimages/dataimages/action"
+		bodyParams = buildImsImageByFileBodyParams(d, cfg)
+		// the data disk image does not support the following parameters
+		for _, key := range []string{"os_version", "is_config", "type", "max_ram", "min_ram", "image_tags"} {
+			delete(bodyParams, key)
+		}
+	case d.Get("is_iso").(bool):
+		createHttpUrl = "v2/cloudimages/iso/action"
+		bodyParams = buildImsImageByFileBodyParams(d, cfg)
+		delete(bodyParams, "os_type")
+	default:
+		createHttpUrl = "v1/cloudimages/wholeimages/action"
+		bodyParams = buildImsWholeImageBodyParams(d, cfg)
+	}
+
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(bodyParams),
+	}
+	createResp, err := client.Request("POST", client.Endpoint+createHttpUrl, &createOpt)
+	if err != nil {
+		return "", fmt.Errorf("error creating IMS image: %s", err)
+	}
+
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return "", err
+	}
+	jobId := utils.PathSearch("job_id", createRespBody, "").(string)
+	if jobId == "" {
+		return "", fmt.Errorf("error creating IMS image: job_id is not found in API response")
+	}
+
+	if err = waitForJobSuccess(ctx, d, client, jobId, schema.TimeoutCreate); err != nil {
+		return "", err
+	}
+	return getImsJobImageId(client, jobId)
+}
+
+// getImsJobImageId returns the image ID of the job, the image ID of the data disk image is returned in the sub job.
+func getImsJobImageId(client *golangsdk.ServiceClient, jobId string) (string, error) {
+	job, _, err := imsJobStatusRefreshFunc(jobId, client)()
+	if err != nil {
+		return "", err
+	}
+
+	imageId := utils.PathSearch("entities.image_id || entities.sub_jobs_result[0].entities.image_id", job, "").(string)
+	if imageId == "" {
+		return "", fmt.Errorf("unable to find the image ID from the job (%s)", jobId)
+	}
+	return imageId, nil
+}
+
 func GetCloudImage(client *golangsdk.ServiceClient, id string) (*cloudimages.Image, error) {
 	listOpts := &cloudimages.ListOpts{
 		ID:    id,
@@ -0,0 +1,122 @@
+package ims
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceImsImageExport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImsImageExportCreate,
+		ReadContext:   resourceImsImageExportRead,
+		DeleteContext: resourceImsImageExportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the image to be exported.`,
+			},
+			"bucket_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the URL of the image file in the OBS bucket, the format is bucket name:file name.`,
+			},
+			"file_format": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"qcow2", "vhd", "zvhd", "vmdk", "raw",
+				}, false),
+				Description: `Specifies the format of the exported image file.`,
+			},
+			"is_quick_export": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to use the quick export.`,
+			},
+		},
+	}
+}
+
+func resourceImsImageExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ims", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating IMS client: %s", err)
+	}
+
+	imageId := d.Get("image_id").(string)
+	exportPath := client.Endpoint + "v1/cloudimages/{image_id}/file"
+	exportPath = strings.ReplaceAll(exportPath, "{image_id}", imageId)
+	exportOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: utils.RemoveNil(map[string]interface{}{
+			"bucket_url":      d.Get("bucket_url"),
+			"file_format":     d.Get("file_format"),
+			"is_quick_export": utils.ValueIngoreEmpty(d.Get("is_quick_export")),
+		}),
+	}
+	exportResp, err := client.Request("POST", exportPath, &exportOpt)
+	if err != nil {
+		return diag.Errorf("error exporting IMS image (%s): %s", imageId, err)
+	}
+
+	exportRespBody, err := utils.FlattenResponse(exportResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	jobId := utils.PathSearch("job_id", exportRespBody, "").(string)
+	if jobId == "" {
+		return diag.Errorf("error exporting IMS image (%s): job_id is not found in API response", imageId)
+	}
+	d.SetId(jobId)
+
+	if err = waitForJobSuccess(ctx, d, client, jobId, schema.TimeoutCreate); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceImsImageExportRead(ctx, d, meta)
+}
+
+func resourceImsImageExportRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	return diag.FromErr(d.Set("region", cfg.GetRegion(d)))
+}
+
+func resourceImsImageExportDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the image export resource is not supported. The resource is only removed from the state, " +
+		"but the exported file remains in the OBS bucket."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
@@ -0,0 +1,140 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceRdsParameters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRdsParametersRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"instance_id", "configuration_id"},
+				Description:  `Specifies the ID of the RDS instance whose effective parameters are queried.`,
+			},
+			"configuration_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the parameter template whose parameters are queried.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the parameter.`,
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the parameter.`,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the value of the parameter.`,
+						},
+						"restart_required": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the instance needs to be rebooted for the parameter to take effect.`,
+						},
+						"readonly": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the parameter is read-only.`,
+						},
+						"value_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the allowed values of the parameter.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the type of the parameter value.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the description of the parameter.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsParametersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	var (
+		id         string
+		parameters *[]model.ConfigurationParameter
+	)
+	if instanceId, ok := d.GetOk("instance_id"); ok {
+		id = instanceId.(string)
+		response, err := client.ShowInstanceConfiguration(&model.ShowInstanceConfigurationRequest{InstanceId: id})
+		if err != nil {
+			return diag.Errorf("error retrieving RDS instance parameters: %s", err)
+		}
+		parameters = response.ConfigurationParameters
+	} else {
+		id = d.Get("configuration_id").(string)
+		response, err := client.ShowConfiguration(&model.ShowConfigurationRequest{ConfigId: id})
+		if err != nil {
+			return diag.Errorf("error retrieving RDS parameter template: %s", err)
+		}
+		parameters = response.ConfigurationParameters
+	}
+
+	name := d.Get("name").(string)
+	result := make([]map[string]interface{}, 0)
+	if parameters != nil {
+		for _, p := range *parameters {
+			if name != "" && p.Name != name {
+				continue
+			}
+			result = append(result, map[string]interface{}{
+				"name":             p.Name,
+				"value":            p.Value,
+				"restart_required": p.RestartRequired,
+				"readonly":         p.Readonly,
+				"value_range":      p.ValueRange,
+				"type":             p.Type.Value(),
+				"description":      p.Description,
+			})
+		}
+	}
+
+	d.SetId(id)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("parameters", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
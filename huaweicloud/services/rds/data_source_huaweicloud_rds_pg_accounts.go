@@ -0,0 +1,95 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceRdsPgAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRdsPgAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RDS PostgreSQL instance.`,
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the account.`,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the account.`,
+						},
+						"attributes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        pgAccountAttributesSchema(),
+							Description: `Indicates the role attributes of the account.`,
+						},
+						"memberof": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `Indicates the default roles of the account.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsPgAccountsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	users, err := QueryPgAccounts(client, instanceId)
+	if err != nil {
+		return diag.Errorf("error retrieving RDS PostgreSQL accounts: %s", err)
+	}
+
+	userName := d.Get("user_name").(string)
+	result := make([]map[string]interface{}, 0, len(users))
+	for _, user := range users {
+		if userName != "" && user.Name != userName {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":       user.Name,
+			"attributes": flattenPgAccountAttributes(user.Attributes),
+			"memberof":   user.Memberof,
+		})
+	}
+
+	d.SetId(instanceId)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("users", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,115 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceRdsPgDatabases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRdsPgDatabasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RDS PostgreSQL instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the database.`,
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the owner of the database.`,
+			},
+			"databases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the database.`,
+						},
+						"owner": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the owner of the database.`,
+						},
+						"character_set": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the character set of the database.`,
+						},
+						"lc_collate": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the collation of the database.`,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the size of the database, in bytes.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsPgDatabasesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	databases, err := QueryPgDatabases(client, instanceId)
+	if err != nil {
+		return diag.Errorf("error retrieving RDS PostgreSQL databases: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	owner := d.Get("owner").(string)
+	result := make([]map[string]interface{}, 0, len(databases))
+	for _, db := range databases {
+		if name != "" && utils.StringValue(db.Name) != name {
+			continue
+		}
+		if owner != "" && utils.StringValue(db.Owner) != owner {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":          db.Name,
+			"owner":         db.Owner,
+			"character_set": db.CharacterSet,
+			"lc_collate":    db.CollateSet,
+			"size":          db.Size,
+		})
+	}
+
+	d.SetId(instanceId)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("databases", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
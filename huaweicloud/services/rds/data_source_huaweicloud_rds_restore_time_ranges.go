@@ -0,0 +1,109 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceRdsRestoreTimeRanges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRdsRestoreTimeRangesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RDS instance.`,
+			},
+			"date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the date to be queried, in the format of yyyy-mm-dd.`,
+			},
+			"restore_time": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the start time of the restoration time range in UNIX timestamp.`,
+						},
+						"end_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the end time of the restoration time range in UNIX timestamp.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsRestoreTimeRangesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	getPath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/restore-time"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", instanceID)
+	if v, ok := d.GetOk("date"); ok {
+		getPath = fmt.Sprintf("%s?date=%v", getPath, v)
+	}
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return diag.Errorf("error retrieving restore time ranges of RDS instance (%s): %s", instanceID, err)
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(instanceID)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("restore_time", flattenRdsRestoreTimeRanges(getRespBody)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenRdsRestoreTimeRanges(resp interface{}) []map[string]interface{} {
+	curArray := utils.PathSearch("restore_time", resp, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(curArray))
+	for i, v := range curArray {
+		result[i] = map[string]interface{}{
+			"start_time": utils.PathSearch("start_time", v, nil),
+			"end_time":   utils.PathSearch("end_time", v, nil),
+		}
+	}
+	return result
+}
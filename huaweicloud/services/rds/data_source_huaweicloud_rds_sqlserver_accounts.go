@@ -0,0 +1,81 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceRdsSqlserverAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRdsSqlserverAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RDS SQL Server instance.`,
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the account.`,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the account.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsSqlserverAccountsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	users, err := QuerySqlserverAccounts(client, instanceId)
+	if err != nil {
+		return diag.Errorf("error retrieving RDS SQL Server accounts: %s", err)
+	}
+
+	userName := d.Get("user_name").(string)
+	result := make([]map[string]interface{}, 0, len(users))
+	for _, user := range users {
+		if userName != "" && user.Name != userName {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name": user.Name,
+		})
+	}
+
+	d.SetId(instanceId)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("users", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
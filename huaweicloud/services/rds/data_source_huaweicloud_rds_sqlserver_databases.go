@@ -0,0 +1,89 @@
+package rds
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceRdsSqlserverDatabases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRdsSqlserverDatabasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RDS SQL Server instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the database.`,
+			},
+			"databases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the database.`,
+						},
+						"character_set": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the character set of the database.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the status of the database.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsSqlserverDatabasesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	databases, err := QuerySqlserverDatabases(client, instanceId, d.Get("name").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving RDS SQL Server databases: %s", err)
+	}
+
+	result := make([]map[string]interface{}, len(databases))
+	for i, db := range databases {
+		result[i] = map[string]interface{}{
+			"name":          db.Name,
+			"character_set": db.CharacterSet,
+			"status":        db.State,
+		}
+	}
+
+	d.SetId(instanceId)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("databases", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
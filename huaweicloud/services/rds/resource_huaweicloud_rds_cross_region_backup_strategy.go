@@ -0,0 +1,213 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsCrossRegionBackupStrategy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsCrossRegionBackupStrategyCreateOrUpdate,
+		UpdateContext: resourceRdsCrossRegionBackupStrategyCreateOrUpdate,
+		ReadContext:   resourceRdsCrossRegionBackupStrategyRead,
+		DeleteContext: resourceRdsCrossRegionBackupStrategyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"backup_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"auto", "all",
+				}, false),
+			},
+			"keep_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1825),
+			},
+			"destination_region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"destination_project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func setRdsCrossRegionBackupPolicy(ctx context.Context, client *v3.RdsClient, instanceId string,
+	timeout time.Duration, policies ...model.OffSiteBackupPolicy) error {
+	request := &model.SetOffSiteBackupPolicyRequest{
+		InstanceId: instanceId,
+		Body: &model.SetOffSiteBackupPolicyRequestBody{
+			PolicyPara: policies,
+		},
+	}
+	log.Printf("[DEBUG] Set RDS cross-region backup policy options: %#v", request)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := client.SetOffSiteBackupPolicy(request)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func resourceRdsCrossRegionBackupStrategyCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	policy := model.OffSiteBackupPolicy{
+		BackupType:           d.Get("backup_type").(string),
+		KeepDays:             int32(d.Get("keep_days").(int)),
+		DestinationRegion:    d.Get("destination_region").(string),
+		DestinationProjectId: d.Get("destination_project_id").(string),
+	}
+
+	policies := []model.OffSiteBackupPolicy{policy}
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+
+		// Switching the backup type from all to auto does not stop the cross-region incremental backups, disable
+		// them by setting the keep days of the incremental policy to 0.
+		if oldType, newType := d.GetChange("backup_type"); oldType.(string) == "all" && newType.(string) == "auto" {
+			oldRegion, _ := d.GetChange("destination_region")
+			oldProjectId, _ := d.GetChange("destination_project_id")
+			policies = append(policies, model.OffSiteBackupPolicy{
+				BackupType:           "incremental",
+				KeepDays:             0,
+				DestinationRegion:    oldRegion.(string),
+				DestinationProjectId: oldProjectId.(string),
+			})
+		}
+	}
+	if err = setRdsCrossRegionBackupPolicy(ctx, client, instanceId, timeout, policies...); err != nil {
+		return diag.Errorf("error setting RDS cross-region backup strategy: %s", err)
+	}
+
+	d.SetId(instanceId)
+	return resourceRdsCrossRegionBackupStrategyRead(ctx, d, meta)
+}
+
+func resourceRdsCrossRegionBackupStrategyRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	response, err := client.ShowOffSiteBackupPolicy(&model.ShowOffSiteBackupPolicyRequest{InstanceId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS cross-region backup strategy")
+	}
+
+	// The policies of the full backups and the incremental backups are returned separately, the keep days is 0 when
+	// the cross-region backup of that type is disabled.
+	var enabled *model.GetOffSiteBackupPolicy
+	backupType := "auto"
+	if response.PolicyPara != nil {
+		for i, policy := range *response.PolicyPara {
+			if policy.KeepDays == nil || *policy.KeepDays == 0 {
+				continue
+			}
+			if enabled == nil {
+				enabled = &(*response.PolicyPara)[i]
+			}
+			if t := utils.StringValue(policy.BackupType); t == "all" || t == "incremental" {
+				backupType = "all"
+			}
+		}
+	}
+	if enabled != nil {
+		mErr := multierror.Append(nil,
+			d.Set("region", region),
+			d.Set("instance_id", d.Id()),
+			d.Set("backup_type", backupType),
+			d.Set("keep_days", enabled.KeepDays),
+			d.Set("destination_region", enabled.DestinationRegion),
+			d.Set("destination_project_id", enabled.DestinationProjectId),
+		)
+		if err = mErr.ErrorOrNil(); err != nil {
+			return diag.Errorf("error setting RDS cross-region backup strategy fields: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] the cross-region backup of RDS instance (%s) is disabled", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceRdsCrossRegionBackupStrategyDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Setting the keep days to 0 disables the cross-region backup.
+	policy := model.OffSiteBackupPolicy{
+		BackupType:           d.Get("backup_type").(string),
+		KeepDays:             0,
+		DestinationRegion:    d.Get("destination_region").(string),
+		DestinationProjectId: d.Get("destination_project_id").(string),
+	}
+	err = setRdsCrossRegionBackupPolicy(ctx, client, d.Id(), d.Timeout(schema.TimeoutDelete), policy)
+	if err != nil {
+		return diag.Errorf("error disabling RDS cross-region backup strategy: %s", err)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/bss/v2/orders"
@@ -18,12 +19,17 @@ import (
 	"github.com/chnsz/golangsdk/openstack/rds/v3/backups"
 	"github.com/chnsz/golangsdk/openstack/rds/v3/instances"
 	"github.com/chnsz/golangsdk/openstack/rds/v3/securities"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 )
 
+// ctxType is the type of the context keys which are used to pass values from Update to Read.
+type ctxType string
+
 // ResourceRdsInstance is the impl for huaweicloud_rds_instance resource
 func ResourceRdsInstance() *schema.Resource {
 	return &schema.Resource{
@@ -35,6 +41,8 @@ func ResourceRdsInstance() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceRdsInstanceCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(30 * time.Minute),
 			Update:  schema.DefaultTimeout(30 * time.Minute),
@@ -90,7 +98,6 @@ func ResourceRdsInstance() *schema.Resource {
 						"version": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"port": {
 							Type:     schema.TypeInt,
@@ -126,6 +133,16 @@ func ResourceRdsInstance() *schema.Resource {
 							Computed: true,
 							ForceNew: true,
 						},
+						"limit_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"trigger_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntInSlice([]int{10, 15, 20}),
+						},
 					},
 				},
 			},
@@ -165,6 +182,65 @@ func ResourceRdsInstance() *schema.Resource {
 				},
 			},
 
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"backup_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"restore.0.restore_time"},
+						},
+						"restore_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"database_name": {
+							Type:          schema.TypeMap,
+							Optional:      true,
+							ForceNew:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"restore.0.tables"},
+						},
+						"tables": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"schema": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"table_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -213,6 +289,13 @@ func ResourceRdsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"binlog_retention_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 168),
+			},
+
 			"parameters": {
 				Type: schema.TypeSet,
 				Elem: &schema.Resource{
@@ -231,6 +314,15 @@ func ResourceRdsInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"restart_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"restart_in_maintenance_window": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{"restart_on_parameter_change"},
+			},
 
 			"nodes": {
 				Type:     schema.TypeList,
@@ -310,6 +402,75 @@ func isMySQLDatabase(d *schema.ResourceData) bool {
 	return strings.ToLower(dbType) == "mysql"
 }
 
+// restoreCreateOpts is used to create an RDS instance with the data restored from the backup or the point in time
+// of the source instance.
+type restoreCreateOpts struct {
+	instances.CreateOpts
+	RestorePoint map[string]interface{}
+}
+
+func (opts restoreCreateOpts) ToInstancesCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToInstancesCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	b["restore_point"] = opts.RestorePoint
+	return b, nil
+}
+
+func buildRdsInstanceRestorePoint(d *schema.ResourceData) map[string]interface{} {
+	restorePoint := map[string]interface{}{
+		"instance_id": d.Get("restore.0.instance_id"),
+	}
+	if backupID, ok := d.GetOk("restore.0.backup_id"); ok {
+		restorePoint["type"] = "backup"
+		restorePoint["backup_id"] = backupID
+	} else {
+		restorePoint["type"] = "timestamp"
+		restorePoint["restore_time"] = d.Get("restore.0.restore_time")
+	}
+	if databases := d.Get("restore.0.database_name").(map[string]interface{}); len(databases) > 0 {
+		restorePoint["database_name"] = databases
+	}
+	if tables := buildRdsInstanceRestoreTables(d.Get("restore.0.tables").([]interface{})); len(tables) > 0 {
+		restorePoint["restore_tables"] = tables
+	}
+	return restorePoint
+}
+
+// buildRdsInstanceRestoreTables groups the tables to be restored by database and schema, which is the structure of
+// the PostgreSQL table-level restoration.
+func buildRdsInstanceRestoreTables(rawTables []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rawTables))
+	databaseIndex := make(map[string]int)
+	for _, raw := range rawTables {
+		table := raw.(map[string]interface{})
+		tableNames := table["table_names"].([]interface{})
+		tables := make([]map[string]interface{}, len(tableNames))
+		for i, name := range tableNames {
+			tables[i] = map[string]interface{}{
+				"old_name": name,
+			}
+		}
+		schemaOpts := map[string]interface{}{
+			"schema": table["schema"],
+			"tables": tables,
+		}
+
+		database := table["database"].(string)
+		if index, ok := databaseIndex[database]; ok {
+			result[index]["schemas"] = append(result[index]["schemas"].([]map[string]interface{}), schemaOpts)
+			continue
+		}
+		databaseIndex[database] = len(result)
+		result = append(result, map[string]interface{}{
+			"database": database,
+			"schemas":  []map[string]interface{}{schemaOpts},
+		})
+	}
+	return result
+}
+
 func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	region := config.GetRegion(d)
@@ -363,7 +524,17 @@ func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 	// Add password here so it wouldn't go in the above log entry
 	createOpts.Password = d.Get("db.0.password").(string)
 
-	res, err := instances.Create(client, createOpts).Extract()
+	var createBuilder instances.CreateRdsBuilder = createOpts
+	if _, ok := d.GetOk("restore"); ok {
+		if _, ok := d.GetOk("restore.0.tables"); ok && !isPostgreSQLDatabase(d) {
+			return diag.Errorf("only PostgreSQL supports restoring specific tables")
+		}
+		createBuilder = restoreCreateOpts{
+			CreateOpts:   createOpts,
+			RestorePoint: buildRdsInstanceRestorePoint(d),
+		}
+	}
+	res, err := instances.Create(client, createBuilder).Extract()
 	if err != nil {
 		return diag.Errorf("error creating RDS instance: %s", err)
 	}
@@ -413,6 +584,25 @@ func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	_, limitSizeSet := d.GetOk("volume.0.limit_size")
+	_, binlogSet := d.GetOk("binlog_retention_hours")
+	if limitSizeSet || binlogSet {
+		hcClient, err := config.HcRdsV3Client(region)
+		if err != nil {
+			return diag.Errorf("error creating RDS v3 client: %s", err)
+		}
+		if limitSizeSet {
+			if err = updateRdsInstanceAutoEnlargePolicy(d, hcClient, instanceID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if binlogSet {
+			if err = updateRdsInstanceBinlogRetentionHours(d, hcClient, instanceID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	tagRaw := d.Get("tags").(map[string]interface{})
 	if len(tagRaw) > 0 {
 		taglist := utils.ExpandResourceTags(tagRaw)
@@ -441,54 +631,15 @@ func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		// Check if we need to restart
-		configs, err := instances.GetConfigurations(client, instanceID).Extract()
+		restartParams, err := getRdsRestartRequiredParameters(client, instanceID, parametersRaw.List())
 		if err != nil {
 			return diag.Errorf("error fetching the instance parameters (%s): %s", instanceID, err)
 		}
 
-		restart := false
-		for _, parameter := range parametersRaw.List() {
-			name := parameter.(map[string]interface{})["name"]
-			for _, v := range configs.Parameters {
-				if v.Name == name {
-					if v.Restart {
-						restart = true
-					}
-					break
-				}
-			}
-			if restart {
-				break
-			}
-		}
-
-		if restart {
+		if len(restartParams) > 0 {
 			// If parameters which requires restart changed, reboot the instance.
-			err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-				_, err = instances.RebootInstance(client, instanceID).Extract()
-				retryable, err := handleMultiOperationsError(err)
-				if retryable {
-					return resource.RetryableError(err)
-				}
-				if err != nil {
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return diag.Errorf("error rebooting for RDS instance (%s): %s", instanceID, err)
-			}
-
-			// wait for the instance state to be 'ACTIVE'.
-			stateConf := &resource.StateChangeConf{
-				Target:       []string{"ACTIVE"},
-				Refresh:      rdsInstanceStateRefreshFunc(client, instanceID),
-				Timeout:      d.Timeout(schema.TimeoutDefault),
-				Delay:        5 * time.Second,
-				PollInterval: 5 * time.Second,
-			}
-			if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-				return diag.Errorf("error waiting for RDS instance (%s) become active state: %s", instanceID, err)
+			if err = restartRdsInstance(ctx, client, instanceID, false, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -544,12 +695,18 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("fixed_ip", privateIps[0])
 	}
 
+	hcClient, err := config.HcRdsV3Client(config.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS v3 client: %s", err)
+	}
+
 	volume := make([]map[string]interface{}, 1)
 	volume[0] = map[string]interface{}{
 		"type":               instance.Volume.Type,
 		"size":               instance.Volume.Size,
 		"disk_encryption_id": instance.DiskEncryptionId,
 	}
+	flattenRdsInstanceAutoEnlargePolicy(hcClient, instanceID, volume[0])
 	if err := d.Set("volume", volume); err != nil {
 		return diag.Errorf("error saving volume to RDS instance (%s): %s", instanceID, err)
 	}
@@ -569,6 +726,15 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("error saving data base to RDS instance (%s): %s", instanceID, err)
 	}
 
+	if isMySQLDatabase(d) {
+		resp, err := hcClient.ShowBinlogClearPolicy(&model.ShowBinlogClearPolicyRequest{InstanceId: instanceID})
+		if err != nil {
+			log.Printf("[WARN] error fetching binlog retention hours of RDS instance (%s): %s", instanceID, err)
+		} else if resp.BinlogRetentionHours != nil {
+			d.Set("binlog_retention_hours", resp.BinlogRetentionHours)
+		}
+	}
+
 	backup := make([]map[string]interface{}, 1)
 	backup[0] = map[string]interface{}{
 		"start_time": instance.BackupStrategy.StartTime,
@@ -613,7 +779,6 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 	if err != nil {
 		log.Printf("[WARN] error fetching parameters of instance (%s): %s", instanceID, err)
 	} else {
-		var restart []string
 		var params []map[string]interface{}
 		for _, parameter := range d.Get("parameters").(*schema.Set).List() {
 			name := parameter.(map[string]interface{})["name"]
@@ -624,9 +789,6 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 						"value": v.Value,
 					}
 					params = append(params, p)
-					if v.Restart {
-						restart = append(restart, v.Name)
-					}
 					break
 				}
 			}
@@ -636,12 +798,13 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 			if err := d.Set("parameters", params); err != nil {
 				log.Printf("error saving parameters to RDS instance (%s): %s", instanceID, err)
 			}
-			if len(restart) > 0 && ctx.Value("parametersChanged") == "true" {
+			if restart, ok := ctx.Value(ctxType("parametersChanged")).([]string); ok && len(restart) > 0 {
 				return diag.Diagnostics{
 					diag.Diagnostic{
 						Severity: diag.Warning,
 						Summary:  "Parameters Changed",
-						Detail:   fmt.Sprintf("Parameters %s changed which needs reboot.", restart),
+						Detail: fmt.Sprintf("Parameters %s changed which needs reboot, the changes are pending "+
+							"until the instance is rebooted.", restart),
 					},
 				}
 			}
@@ -682,10 +845,31 @@ func resourceRdsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := updateRdsInstanceMajorVersion(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := updateRdsInstanceVolumeSize(d, client, instanceID); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if d.HasChanges("volume.0.limit_size", "volume.0.trigger_threshold", "binlog_retention_hours") {
+		hcClient, err := config.HcRdsV3Client(config.GetRegion(d))
+		if err != nil {
+			return diag.Errorf("error creating RDS v3 client: %s", err)
+		}
+		if d.HasChanges("volume.0.limit_size", "volume.0.trigger_threshold") {
+			if err = updateRdsInstanceAutoEnlargePolicy(d, hcClient, instanceID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if d.HasChange("binlog_retention_hours") {
+			if err = updateRdsInstanceBinlogRetentionHours(d, hcClient, instanceID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	if err := updateRdsInstanceBackpStrategy(d, client, instanceID); err != nil {
 		return diag.FromErr(err)
 	}
@@ -724,7 +908,6 @@ func resourceRdsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.HasChange("parameters") {
-		type ctxType string
 		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			err := updateRdsParameters(d, client, instanceID)
 			retryable, err := handleMultiOperationsError(err)
@@ -739,8 +922,24 @@ func resourceRdsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return diag.Errorf("error updating parameters of RDS instance (%s): %s", instanceID, err)
 		}
-		// Sending parametersChanged to Read to warn users the instance needs a reboot.
-		ctx = context.WithValue(ctx, ctxType("parametersChanged"), "true")
+
+		o, n := d.GetChange("parameters")
+		changed := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+		restartParams, err := getRdsRestartRequiredParameters(client, instanceID, changed)
+		if err != nil {
+			return diag.Errorf("error fetching the instance parameters (%s): %s", instanceID, err)
+		}
+		if len(restartParams) > 0 {
+			if d.Get("restart_on_parameter_change").(bool) {
+				delay := d.Get("restart_in_maintenance_window").(bool)
+				if err = restartRdsInstance(ctx, client, instanceID, delay, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return diag.FromErr(err)
+				}
+			} else {
+				// Sending parametersChanged to Read to warn users the instance needs a reboot.
+				ctx = context.WithValue(ctx, ctxType("parametersChanged"), restartParams)
+			}
+		}
 	}
 
 	return resourceRdsInstanceRead(ctx, d, meta)
@@ -1102,6 +1301,80 @@ func updateRdsParameters(d *schema.ResourceData, client *golangsdk.ServiceClient
 	return nil
 }
 
+// getRdsRestartRequiredParameters returns the names of the parameters which take effect only after the instance is
+// rebooted.
+func getRdsRestartRequiredParameters(client *golangsdk.ServiceClient, instanceID string,
+	parameters []interface{}) ([]string, error) {
+	configs, err := instances.GetConfigurations(client, instanceID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var restart []string
+	for _, parameter := range parameters {
+		name := parameter.(map[string]interface{})["name"]
+		for _, v := range configs.Parameters {
+			if v.Name == name {
+				if v.Restart {
+					restart = append(restart, v.Name)
+				}
+				break
+			}
+		}
+	}
+	return restart, nil
+}
+
+// restartRdsInstance reboots the instance and waits for it to become active. If delay is true, the instance is
+// rebooted during the maintenance window and the function returns once the reboot is scheduled.
+func restartRdsInstance(ctx context.Context, client *golangsdk.ServiceClient, instanceID string, delay bool,
+	timeout time.Duration) error {
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		if delay {
+			opts := map[string]interface{}{
+				"restart": map[string]interface{}{
+					"delay": true,
+				},
+			}
+			_, err = client.Post(client.ServiceURL("instances", instanceID, "action"), opts, nil,
+				&golangsdk.RequestOpts{
+					OkCodes: []int{200, 202},
+				})
+		} else {
+			_, err = instances.RebootInstance(client, instanceID).Extract()
+		}
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error rebooting for RDS instance (%s): %s", instanceID, err)
+	}
+	if delay {
+		log.Printf("[DEBUG] RDS instance (%s) will be rebooted during the maintenance window", instanceID)
+		return nil
+	}
+
+	// wait for the instance state to be 'ACTIVE'.
+	stateConf := &resource.StateChangeConf{
+		Target:       []string{"ACTIVE"},
+		Refresh:      rdsInstanceStateRefreshFunc(client, instanceID),
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for RDS instance (%s) become active state: %s", instanceID, err)
+	}
+	return nil
+}
+
 func configRdsInstanceSSL(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
 	sslEnable := d.Get("ssl_enable").(bool)
 	udpateOpts := securities.SSLOpts{
@@ -1177,3 +1450,171 @@ func parameterToHash(v interface{}) int {
 	m := v.(map[string]interface{})
 	return hashcode.String(m["name"].(string) + m["value"].(string))
 }
+
+func isPostgreSQLDatabase(d *schema.ResourceData) bool {
+	return strings.ToLower(d.Get("db.0.type").(string)) == "postgresql"
+}
+
+// postRdsInstanceJob sends the request of the instance action and waits for the job to be completed if the action is
+// executed asynchronously.
+func postRdsInstanceJob(client *golangsdk.ServiceClient, url string, opts map[string]interface{},
+	timeout time.Duration) error {
+	var r golangsdk.Result
+	_, r.Err = client.Post(url, opts, &r.Body, &golangsdk.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	if r.Err != nil {
+		return r.Err
+	}
+
+	var job struct {
+		JobId string `json:"job_id"`
+	}
+	if err := r.ExtractInto(&job); err != nil {
+		return err
+	}
+	if job.JobId == "" {
+		return nil
+	}
+	return checkRDSInstanceJobFinish(client, job.JobId, timeout)
+}
+
+// compareRdsVersions compares the database versions by their numeric parts, e.g. 5.7 < 8.0 and 9.6 < 12.
+func compareRdsVersions(v1, v2 string) int {
+	parts1, parts2 := strings.Split(v1, "."), strings.Split(v2, ".")
+	for i := 0; i < len(parts1) || i < len(parts2); i++ {
+		var n1, n2 int
+		if i < len(parts1) {
+			n1, _ = strconv.Atoi(parts1[i])
+		}
+		if i < len(parts2) {
+			n2, _ = strconv.Atoi(parts2[i])
+		}
+		if n1 != n2 {
+			if n1 < n2 {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// resourceRdsInstanceCustomizeDiff replaces the instance when the version of the databases other than MySQL and
+// PostgreSQL is changed, since only their major versions can be upgraded in place, and rejects the downgrades.
+func resourceRdsInstanceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("db.0.version") {
+		return nil
+	}
+
+	dbType := strings.ToLower(d.Get("db.0.type").(string))
+	if dbType != "mysql" && dbType != "postgresql" {
+		return d.ForceNew("db.0.version")
+	}
+	oldVersion, newVersion := d.GetChange("db.0.version")
+	if compareRdsVersions(newVersion.(string), oldVersion.(string)) < 0 {
+		return fmt.Errorf("the database version can not be downgraded from %s to %s", oldVersion, newVersion)
+	}
+	return nil
+}
+
+// updateRdsInstanceMajorVersion upgrades the major version of the database in place, the PostgreSQL instances are
+// checked before the upgrade.
+func updateRdsInstanceMajorVersion(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChange("db.0.version") {
+		return nil
+	}
+	if !isMySQLDatabase(d) && !isPostgreSQLDatabase(d) {
+		return fmt.Errorf("only MySQL and PostgreSQL databases support major version upgrade")
+	}
+
+	targetVersion := d.Get("db.0.version").(string)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if isPostgreSQLDatabase(d) {
+		checkOpts := map[string]interface{}{
+			"target_version": targetVersion,
+		}
+		log.Printf("[DEBUG] Major version upgrade check opts: %#v", checkOpts)
+		err := postRdsInstanceJob(client, client.ServiceURL("instances", instanceID, "major-version", "upgrade-check"),
+			checkOpts, timeout)
+		if err != nil {
+			return fmt.Errorf("error checking the major version upgrade of RDS instance (%s): %s", instanceID, err)
+		}
+	}
+
+	upgradeOpts := map[string]interface{}{
+		"target_version": targetVersion,
+	}
+	if isPostgreSQLDatabase(d) {
+		// The upgraded instance takes over the private IP of the original instance.
+		upgradeOpts["is_change_private_ip"] = true
+	}
+	log.Printf("[DEBUG] Major version upgrade opts: %#v", upgradeOpts)
+	err := postRdsInstanceJob(client, client.ServiceURL("instances", instanceID, "major-version", "upgrade"),
+		upgradeOpts, timeout)
+	if err != nil {
+		return fmt.Errorf("error upgrading the major version of RDS instance (%s): %s", instanceID, err)
+	}
+	return nil
+}
+
+// updateRdsInstanceAutoEnlargePolicy configures the storage autoscaling of the instance, the autoscaling is disabled
+// if the limit size is 0.
+func updateRdsInstanceAutoEnlargePolicy(d *schema.ResourceData, client *v3.RdsClient, instanceID string) error {
+	limitSize := d.Get("volume.0.limit_size").(int)
+	opts := model.CustomerModifyAutoEnlargePolicyReq{
+		SwitchOption: limitSize > 0,
+	}
+	if limitSize > 0 {
+		opts.LimitSize = utils.Int32(int32(limitSize))
+		if v, ok := d.GetOk("volume.0.trigger_threshold"); ok {
+			var threshold model.CustomerModifyAutoEnlargePolicyReqTriggerThreshold
+			if err := threshold.UnmarshalJSON([]byte(strconv.Itoa(v.(int)))); err != nil {
+				return err
+			}
+			opts.TriggerThreshold = &threshold
+		}
+	}
+
+	log.Printf("[DEBUG] Storage autoscaling opts: %#v", opts)
+	_, err := client.SetAutoEnlargePolicy(&model.SetAutoEnlargePolicyRequest{
+		InstanceId: instanceID,
+		Body:       &opts,
+	})
+	if err != nil {
+		return fmt.Errorf("error configuring storage autoscaling of RDS instance (%s): %s", instanceID, err)
+	}
+	return nil
+}
+
+func updateRdsInstanceBinlogRetentionHours(d *schema.ResourceData, client *v3.RdsClient, instanceID string) error {
+	if !isMySQLDatabase(d) {
+		return fmt.Errorf("only MySQL database support binlog retention")
+	}
+
+	_, err := client.SetBinlogClearPolicy(&model.SetBinlogClearPolicyRequest{
+		InstanceId: instanceID,
+		Body: &model.BinlogClearPolicyRequestBody{
+			BinlogRetentionHours: int64(d.Get("binlog_retention_hours").(int)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error configuring binlog retention hours of RDS instance (%s): %s", instanceID, err)
+	}
+	return nil
+}
+
+func flattenRdsInstanceAutoEnlargePolicy(client *v3.RdsClient, instanceID string, volume map[string]interface{}) {
+	resp, err := client.ShowAutoEnlargePolicy(&model.ShowAutoEnlargePolicyRequest{InstanceId: instanceID})
+	if err != nil {
+		log.Printf("[WARN] error fetching storage autoscaling of RDS instance (%s): %s", instanceID, err)
+		return
+	}
+	volume["limit_size"] = 0
+	if resp.SwitchOption != nil && *resp.SwitchOption && resp.LimitSize != nil {
+		volume["limit_size"] = int(*resp.LimitSize)
+	}
+	if resp.TriggerThreshold != nil {
+		volume["trigger_threshold"] = int(*resp.TriggerThreshold)
+	}
+}
@@ -0,0 +1,464 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsPgAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsPgAccountCreate,
+		UpdateContext: resourceRdsPgAccountUpdate,
+		DeleteContext: resourceRdsPgAccountDelete,
+		ReadContext:   resourceRdsPgAccountRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     pgAccountAttributesResourceSchema(),
+			},
+			"memberof": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func pgAccountAttributesSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"rolsuper": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rolinherit": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rolcreaterole": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rolcreatedb": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rolcanlogin": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rolconnlimit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rolreplication": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"rolbypassrls": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// pgAccountAttributesResourceSchema returns the attributes schema of the account resource, in which the role
+// attributes that can be granted or revoked are configurable.
+func pgAccountAttributesResourceSchema() *schema.Resource {
+	attributesSchema := pgAccountAttributesSchema()
+	for key := range pgAccountPrivileges {
+		attributesSchema.Schema[key].Optional = true
+	}
+	return attributesSchema
+}
+
+func resourceRdsPgAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	dbUser := d.Get("name").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	createOpts := &model.CreatePostgresqlDbUserRequest{
+		InstanceId: instanceId,
+		Body: &model.PostgresqlUserForCreation{
+			Name:     dbUser,
+			Password: d.Get("password").(string),
+		},
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err = client.CreatePostgresqlDbUser(createOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL account: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbUser)
+
+	if err = updatePgAccountAttributes(ctx, c, d, true); err != nil {
+		return diag.FromErr(err)
+	}
+	if v, ok := d.GetOk("memberof"); ok {
+		roles := v.(*schema.Set).List()
+		if err = updatePgAccountRoles(ctx, c, d, "POST", roles); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceRdsPgAccountRead(ctx, d, meta)
+}
+
+// pgAccountPrivileges maps the configurable role attributes to the privileges which grant and revoke them.
+var pgAccountPrivileges = map[string][]string{
+	"rolcreaterole":  {"CREATEROLE", "NOCREATEROLE"},
+	"rolcreatedb":    {"CREATEDB", "NOCREATEDB"},
+	"rolcanlogin":    {"LOGIN", "NOLOGIN"},
+	"rolreplication": {"REPLICATION", "NOREPLICATION"},
+}
+
+func doPgAccountRequest(ctx context.Context, d *schema.ResourceData, client *golangsdk.ServiceClient, method,
+	path string, body map[string]interface{}, timeout time.Duration) error {
+	requestPath := client.Endpoint + path
+	requestPath = strings.ReplaceAll(requestPath, "{project_id}", client.ProjectID)
+	requestPath = strings.ReplaceAll(requestPath, "{instance_id}", d.Get("instance_id").(string))
+	requestOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: body,
+	}
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := client.Request(method, requestPath, &requestOpt)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// updatePgAccountAttributes grants or revokes the role attributes which are specified in the configuration. Only
+// the changed attributes are updated unless the account is just created.
+func updatePgAccountAttributes(ctx context.Context, c *config.Config, d *schema.ResourceData, isCreate bool) error {
+	rawAttributes := d.GetRawConfig().GetAttr("attributes")
+	if rawAttributes.IsNull() || !rawAttributes.IsKnown() || rawAttributes.LengthInt() == 0 {
+		return nil
+	}
+
+	client, err := c.NewServiceClient("rds", c.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if isCreate {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	rawAttribute := rawAttributes.Index(cty.NumberIntVal(0))
+	for key, privileges := range pgAccountPrivileges {
+		if rawValue := rawAttribute.GetAttr(key); rawValue.IsNull() || !rawValue.IsKnown() {
+			continue
+		}
+		if !isCreate && !d.HasChange("attributes.0."+key) {
+			continue
+		}
+
+		privilege := privileges[1]
+		if d.Get("attributes.0." + key).(bool) {
+			privilege = privileges[0]
+		}
+		body := map[string]interface{}{
+			"user_name":      d.Get("name"),
+			"authority_type": "role",
+			"privilege":      privilege,
+		}
+		err = doPgAccountRequest(ctx, d, client, "PUT", "v3/{project_id}/instances/{instance_id}/db-user-privilege",
+			body, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating the role attribute (%s) of RDS PostgreSQL account: %s", key, err)
+		}
+	}
+	return nil
+}
+
+// updatePgAccountRoles grants (POST) the roles to the account or revokes (DELETE) them from the account.
+func updatePgAccountRoles(ctx context.Context, c *config.Config, d *schema.ResourceData, method string,
+	roles []interface{}) error {
+	client, err := c.NewServiceClient("rds", c.GetRegion(d))
+	if err != nil {
+		return fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	body := map[string]interface{}{
+		"user":  d.Get("name"),
+		"roles": roles,
+	}
+	err = doPgAccountRequest(ctx, d, client, method, "v3/{project_id}/instances/{instance_id}/db-user-role",
+		body, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error updating the roles of RDS PostgreSQL account: %s", err)
+	}
+	return nil
+}
+
+// QueryPgAccounts returns all accounts of the RDS PostgreSQL instance.
+func QueryPgAccounts(client *v3.RdsClient, instanceId string) ([]model.PostgresqlUserForList, error) {
+	request := &model.ListPostgresqlDbUserPaginatedRequest{
+		InstanceId: instanceId,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	allUsers := make([]model.PostgresqlUserForList, 0)
+	for {
+		response, err := client.ListPostgresqlDbUserPaginated(request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Users == nil || len(*response.Users) == 0 {
+			break
+		}
+		allUsers = append(allUsers, *response.Users...)
+		request.Page += 1
+	}
+	return allUsers, nil
+}
+
+func flattenPgAccountAttributes(attributes *interface{}) []map[string]interface{} {
+	if attributes == nil {
+		return nil
+	}
+
+	attrs := *attributes
+	return []map[string]interface{}{
+		{
+			"rolsuper":       utils.PathSearch("rolsuper", attrs, nil),
+			"rolinherit":     utils.PathSearch("rolinherit", attrs, nil),
+			"rolcreaterole":  utils.PathSearch("rolcreaterole", attrs, nil),
+			"rolcreatedb":    utils.PathSearch("rolcreatedb", attrs, nil),
+			"rolcanlogin":    utils.PathSearch("rolcanlogin", attrs, nil),
+			"rolconnlimit":   utils.PathSearch("rolconnlimit", attrs, nil),
+			"rolreplication": utils.PathSearch("rolreplication", attrs, nil),
+			"rolbypassrls":   utils.PathSearch("rolbypassrls", attrs, nil),
+		},
+	}
+}
+
+func resourceRdsPgAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and user from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<user>")
+	}
+	instanceId := parts[0]
+	dbUser := parts[1]
+
+	users, err := QueryPgAccounts(client, instanceId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL accounts")
+	}
+
+	for _, user := range users {
+		if user.Name != dbUser {
+			continue
+		}
+		mErr := multierror.Append(nil,
+			d.Set("region", region),
+			d.Set("instance_id", instanceId),
+			d.Set("name", dbUser),
+			d.Set("attributes", flattenPgAccountAttributes(user.Attributes)),
+			d.Set("memberof", user.Memberof),
+		)
+		if err = mErr.ErrorOrNil(); err != nil {
+			return diag.Errorf("error setting RDS PostgreSQL account fields: %s", err)
+		}
+		return nil
+	}
+
+	d.SetId("")
+	log.Printf("[WARN] failed to fetch RDS PostgreSQL account %s: deleted", dbUser)
+	return nil
+}
+
+func resourceRdsPgAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	if d.HasChange("password") {
+		if err = updatePgAccountPassword(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("attributes") {
+		if err = updatePgAccountAttributes(ctx, c, d, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("memberof") {
+		oldRaw, newRaw := d.GetChange("memberof")
+		oldRoles, newRoles := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		if revokeRoles := oldRoles.Difference(newRoles).List(); len(revokeRoles) > 0 {
+			if err = updatePgAccountRoles(ctx, c, d, "DELETE", revokeRoles); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if grantRoles := newRoles.Difference(oldRoles).List(); len(grantRoles) > 0 {
+			if err = updatePgAccountRoles(ctx, c, d, "POST", grantRoles); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceRdsPgAccountRead(ctx, d, meta)
+}
+
+func updatePgAccountPassword(ctx context.Context, client *v3.RdsClient, d *schema.ResourceData) error {
+	updateOpts := &model.SetPostgresqlDbUserPwdRequest{
+		InstanceId: d.Get("instance_id").(string),
+		Body: &model.DbUserPwdRequest{
+			Name:     d.Get("name").(string),
+			Password: d.Get("password").(string),
+		},
+	}
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err := client.SetPostgresqlDbUserPwd(updateOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating the password of RDS PostgreSQL account: %s", err)
+	}
+	return nil
+}
+
+func resourceRdsPgAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	// The accounts of MySQL and PostgreSQL instances are deleted through the same API.
+	deleteOpts := &model.DeleteDbUserRequest{
+		InstanceId: instanceId,
+		UserName:   d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS PostgreSQL account options: %#v", deleteOpts)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err = client.DeleteDbUser(deleteOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS PostgreSQL account: %s", err)
+	}
+
+	return nil
+}
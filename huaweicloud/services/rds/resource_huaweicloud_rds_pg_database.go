@@ -0,0 +1,243 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsPgDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsPgDatabaseCreate,
+		DeleteContext: resourceRdsPgDatabaseDelete,
+		ReadContext:   resourceRdsPgDatabaseRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"template0", "template1"}, false),
+			},
+			"lc_collate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"lc_ctype": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"is_revoke_public_privilege": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRdsPgDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("name").(string)
+	createOpts := &model.CreatePostgresqlDatabaseRequest{
+		InstanceId: instanceId,
+		Body: &model.PostgresqlDatabaseForCreation{
+			Name:                    dbName,
+			Owner:                   utils.StringIgnoreEmpty(d.Get("owner").(string)),
+			CharacterSet:            utils.StringIgnoreEmpty(d.Get("character_set").(string)),
+			Template:                utils.StringIgnoreEmpty(d.Get("template").(string)),
+			LcCollate:               utils.StringIgnoreEmpty(d.Get("lc_collate").(string)),
+			LcCtype:                 utils.StringIgnoreEmpty(d.Get("lc_ctype").(string)),
+			IsRevokePublicPrivilege: utils.Bool(d.Get("is_revoke_public_privilege").(bool)),
+		},
+	}
+	log.Printf("[DEBUG] Create RDS PostgreSQL database options: %#v", createOpts)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err = client.CreatePostgresqlDatabase(createOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL database: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbName)
+	return resourceRdsPgDatabaseRead(ctx, d, meta)
+}
+
+// QueryPgDatabases returns all databases of the RDS PostgreSQL instance.
+func QueryPgDatabases(client *v3.RdsClient, instanceId string) ([]model.PostgresqlListDatabase, error) {
+	request := &model.ListPostgresqlDatabasesRequest{
+		InstanceId: instanceId,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	allDatabases := make([]model.PostgresqlListDatabase, 0)
+	for {
+		response, err := client.ListPostgresqlDatabases(request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Databases == nil || len(*response.Databases) == 0 {
+			break
+		}
+		allDatabases = append(allDatabases, *response.Databases...)
+		request.Page += 1
+	}
+	return allDatabases, nil
+}
+
+func resourceRdsPgDatabaseRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+
+	databases, err := QueryPgDatabases(client, instanceId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL databases")
+	}
+
+	for _, db := range databases {
+		if utils.StringValue(db.Name) != dbName {
+			continue
+		}
+		mErr := multierror.Append(nil,
+			d.Set("region", region),
+			d.Set("instance_id", instanceId),
+			d.Set("name", dbName),
+			d.Set("owner", db.Owner),
+			d.Set("character_set", db.CharacterSet),
+			d.Set("lc_collate", db.CollateSet),
+			d.Set("size", db.Size),
+		)
+		if err = mErr.ErrorOrNil(); err != nil {
+			return diag.Errorf("error setting RDS PostgreSQL database fields: %s", err)
+		}
+		return nil
+	}
+
+	d.SetId("")
+	log.Printf("[WARN] failed to fetch RDS PostgreSQL database %s: deleted", dbName)
+	return nil
+}
+
+func resourceRdsPgDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	// The databases of MySQL and PostgreSQL instances are deleted through the same API.
+	deleteOpts := &model.DeleteDatabaseRequest{
+		InstanceId: instanceId,
+		DbName:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS PostgreSQL database options: %#v", deleteOpts)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err = client.DeleteDatabase(deleteOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS PostgreSQL database: %s", err)
+	}
+
+	return nil
+}
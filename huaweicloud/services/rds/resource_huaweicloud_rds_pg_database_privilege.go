@@ -0,0 +1,249 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsPgDatabasePrivilege() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsPgDatabasePrivilegeCreate,
+		DeleteContext: resourceRdsPgDatabasePrivilegeDelete,
+		ReadContext:   resourceRdsPgDatabasePrivilegeRead,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceRdsPgDatabasePrivilegeCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildPgUserOpts(rawUsers []interface{}) []model.PostgresqlUserWithPrivilege {
+	usersOpts := make([]model.PostgresqlUserWithPrivilege, len(rawUsers))
+	for i, v := range rawUsers {
+		user := v.(map[string]interface{})
+		usersOpts[i] = model.PostgresqlUserWithPrivilege{
+			Name:       user["name"].(string),
+			SchemaName: user["schema_name"].(string),
+			Readonly:   user["readonly"].(bool),
+		}
+	}
+	return usersOpts
+}
+
+// buildPgDatabasePrivilegeId builds the resource ID in the format
+// <instance_id>/<db_name>/<user_name>:<schema_name>[,<user_name>:<schema_name>...], the user and schema pairs are
+// sorted so that the resources authorizing different users of the same database do not collide.
+func buildPgDatabasePrivilegeId(instanceId, dbName string, rawUsers []interface{}) string {
+	userSchemas := make([]string, len(rawUsers))
+	for i, v := range rawUsers {
+		user := v.(map[string]interface{})
+		userSchemas[i] = fmt.Sprintf("%s:%s", user["name"], user["schema_name"])
+	}
+	sort.Strings(userSchemas)
+	return strings.Join([]string{instanceId, dbName, strings.Join(userSchemas, ",")}, "/")
+}
+
+// resourceRdsPgDatabasePrivilegeCustomizeDiff rejects the changes which need to revoke the privileges, since the
+// privileges can not be revoked and the removed users would keep them after the resource is replaced.
+func resourceRdsPgDatabasePrivilegeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("users") {
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("users")
+	newReadonly := make(map[string]bool)
+	for _, v := range newRaw.(*schema.Set).List() {
+		user := v.(map[string]interface{})
+		newReadonly[fmt.Sprintf("%s:%s", user["name"], user["schema_name"])] = user["readonly"].(bool)
+	}
+	for _, v := range oldRaw.(*schema.Set).List() {
+		user := v.(map[string]interface{})
+		readonly, ok := newReadonly[fmt.Sprintf("%s:%s", user["name"], user["schema_name"])]
+		if !ok {
+			return fmt.Errorf("the privileges of user (%s) in schema (%s) can not be revoked, removing users is not "+
+				"supported", user["name"], user["schema_name"])
+		}
+		if readonly && !user["readonly"].(bool) {
+			return fmt.Errorf("the write privileges of user (%s) in schema (%s) can not be revoked, changing readonly "+
+				"from false to true is not supported", user["name"], user["schema_name"])
+		}
+	}
+	return nil
+}
+
+func resourceRdsPgDatabasePrivilegeCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	createOpts := model.PostgresqlGrantRequest{
+		DbName: dbName,
+		Users:  buildPgUserOpts(d.Get("users").(*schema.Set).List()),
+	}
+	log.Printf("[DEBUG] Create RDS PostgreSQL database privilege options: %#v", createOpts)
+
+	privilegeReq := model.AllowDbPrivilegeRequest{
+		InstanceId: instanceId,
+		Body:       &createOpts,
+	}
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+	config.MutexKV.Lock(dbName)
+	defer config.MutexKV.Unlock(dbName)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err = client.AllowDbPrivilege(&privilegeReq)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL database privilege: %s", err)
+	}
+
+	d.SetId(buildPgDatabasePrivilegeId(instanceId, dbName, d.Get("users").(*schema.Set).List()))
+	return resourceRdsPgDatabasePrivilegeRead(ctx, d, meta)
+}
+
+func resourceRdsPgDatabasePrivilegeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id, database and users from resource id
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid id format, must be " +
+			"<instance_id>/<database_name>/<user_name>:<schema_name>[,<user_name>:<schema_name>...]")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+
+	// The authorized users of the PostgreSQL database cannot be queried, so only the existence of the database is
+	// checked.
+	databases, err := QueryPgDatabases(client, instanceId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL databases")
+	}
+	for _, db := range databases {
+		if utils.StringValue(db.Name) == dbName {
+			mErr := multierror.Append(nil,
+				d.Set("region", region),
+				d.Set("instance_id", instanceId),
+				d.Set("db_name", dbName),
+			)
+			// The users are only known from the resource ID after importing, and the read-only permission is lost.
+			if d.Get("users").(*schema.Set).Len() == 0 {
+				mErr = multierror.Append(mErr, d.Set("users", flattenPgDatabasePrivilegeUsers(parts[2])))
+			}
+			return diag.FromErr(mErr.ErrorOrNil())
+		}
+	}
+
+	d.SetId("")
+	log.Printf("[WARN] failed to fetch RDS PostgreSQL database %s: deleted", dbName)
+	return nil
+}
+
+func flattenPgDatabasePrivilegeUsers(userSchemas string) []map[string]interface{} {
+	rst := make([]map[string]interface{}, 0)
+	for _, userSchema := range strings.Split(userSchemas, ",") {
+		pair := strings.SplitN(userSchema, ":", 2)
+		if len(pair) != 2 {
+			continue
+		}
+		rst = append(rst, map[string]interface{}{
+			"name":        pair[0],
+			"schema_name": pair[1],
+		})
+	}
+	return rst
+}
+
+func resourceRdsPgDatabasePrivilegeDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the RDS PostgreSQL database privilege is not supported. The resource is only removed from " +
+		"the state, but the privileges remain granted to the users."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
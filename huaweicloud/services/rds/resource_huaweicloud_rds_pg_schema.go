@@ -0,0 +1,175 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceRdsPgSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsPgSchemaCreate,
+		DeleteContext: resourceRdsPgSchemaDelete,
+		ReadContext:   resourceRdsPgSchemaRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schema_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRdsPgSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	schemaName := d.Get("schema_name").(string)
+	createOpts := &model.CreatePostgresqlDatabaseSchemaRequest{
+		InstanceId: instanceId,
+		Body: &model.PostgresqlDatabaseSchemaReq{
+			DbName: dbName,
+			Schemas: []model.PostgresqlCreateSchemaReq{
+				{
+					SchemaName: schemaName,
+					Owner:      d.Get("owner").(string),
+				},
+			},
+		},
+	}
+	log.Printf("[DEBUG] Create RDS PostgreSQL schema options: %#v", createOpts)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err = client.CreatePostgresqlDatabaseSchema(createOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS PostgreSQL schema: %s", err)
+	}
+
+	d.SetId(strings.Join([]string{instanceId, dbName, schemaName}, "/"))
+	return resourceRdsPgSchemaRead(ctx, d, meta)
+}
+
+func resourceRdsPgSchemaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id, database and schema from resource id
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>/<schema_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+	schemaName := parts[2]
+
+	request := &model.ListPostgresqlDatabaseSchemasRequest{
+		InstanceId: instanceId,
+		DbName:     dbName,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+	for {
+		response, err := client.ListPostgresqlDatabaseSchemas(request)
+		if err != nil {
+			return common.CheckDeletedDiag(d, err, "error listing RDS PostgreSQL schemas")
+		}
+		if response.DatabaseSchemas == nil || len(*response.DatabaseSchemas) == 0 {
+			break
+		}
+		request.Page += 1
+		for _, s := range *response.DatabaseSchemas {
+			if s.SchemaName != schemaName {
+				continue
+			}
+			mErr := multierror.Append(nil,
+				d.Set("region", region),
+				d.Set("instance_id", instanceId),
+				d.Set("db_name", dbName),
+				d.Set("schema_name", schemaName),
+				d.Set("owner", s.Owner),
+			)
+			if err = mErr.ErrorOrNil(); err != nil {
+				return diag.Errorf("error setting RDS PostgreSQL schema fields: %s", err)
+			}
+			return nil
+		}
+	}
+
+	d.SetId("")
+	log.Printf("[WARN] failed to fetch RDS PostgreSQL schema %s: deleted", schemaName)
+	return nil
+}
+
+func resourceRdsPgSchemaDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting the RDS PostgreSQL schema is not supported. The resource is only removed from the state, " +
+		"but the schema remains in the database until the database is deleted."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsSqlAudit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsSqlAuditCreateOrUpdate,
+		UpdateContext: resourceRdsSqlAuditCreateOrUpdate,
+		ReadContext:   resourceRdsSqlAuditRead,
+		DeleteContext: resourceRdsSqlAuditDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keep_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 732),
+			},
+			// Whether to keep the historical audit logs when the SQL audit is disabled.
+			"reserve_auditlogs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func setRdsAuditlogPolicy(ctx context.Context, client *v3.RdsClient, instanceId string,
+	opts model.SetAuditlogPolicyRequestBody, timeout time.Duration) error {
+	request := &model.SetAuditlogPolicyRequest{
+		InstanceId: instanceId,
+		Body:       &opts,
+	}
+	log.Printf("[DEBUG] Set RDS audit log policy options: %#v", opts)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := client.SetAuditlogPolicy(request)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func resourceRdsSqlAuditCreateOrUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	opts := model.SetAuditlogPolicyRequestBody{
+		KeepDays: int32(d.Get("keep_days").(int)),
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+	if err = setRdsAuditlogPolicy(ctx, client, instanceId, opts, timeout); err != nil {
+		return diag.Errorf("error setting RDS SQL audit: %s", err)
+	}
+
+	d.SetId(instanceId)
+	return resourceRdsSqlAuditRead(ctx, d, meta)
+}
+
+func resourceRdsSqlAuditRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	response, err := client.ShowAuditlogPolicy(&model.ShowAuditlogPolicyRequest{InstanceId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS SQL audit")
+	}
+
+	// The keep days is 0 when the SQL audit is disabled.
+	if response.KeepDays == nil || *response.KeepDays == 0 {
+		log.Printf("[WARN] the SQL audit of RDS instance (%s) is disabled", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", d.Id()),
+		d.Set("keep_days", response.KeepDays),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS SQL audit fields: %s", err)
+	}
+	return nil
+}
+
+func resourceRdsSqlAuditDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Setting the keep days to 0 disables the SQL audit.
+	opts := model.SetAuditlogPolicyRequestBody{
+		KeepDays:         0,
+		ReserveAuditlogs: utils.Bool(d.Get("reserve_auditlogs").(bool)),
+	}
+	if err = setRdsAuditlogPolicy(ctx, client, d.Id(), opts, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error disabling RDS SQL audit: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceRdsSqlserverAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsSqlserverAccountCreate,
+		UpdateContext: resourceRdsSqlserverAccountUpdate,
+		DeleteContext: resourceRdsSqlserverAccountDelete,
+		ReadContext:   resourceRdsSqlserverAccountRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceRdsSqlserverAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	dbUser := d.Get("name").(string)
+	instanceId := d.Get("instance_id").(string)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	createOpts := &model.CreateSqlserverDbUserRequest{
+		InstanceId: instanceId,
+		Body: &model.SqlserverUserForCreation{
+			Name:     dbUser,
+			Password: d.Get("password").(string),
+		},
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err = client.CreateSqlserverDbUser(createOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS SQL Server account: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbUser)
+	return resourceRdsSqlserverAccountRead(ctx, d, meta)
+}
+
+// QuerySqlserverAccounts returns all accounts of the RDS SQL Server instance.
+func QuerySqlserverAccounts(client *v3.RdsClient, instanceId string) ([]model.UserForList, error) {
+	request := &model.ListSqlserverDbUsersRequest{
+		InstanceId: instanceId,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	allUsers := make([]model.UserForList, 0)
+	for {
+		response, err := client.ListSqlserverDbUsers(request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Users == nil || len(*response.Users) == 0 {
+			break
+		}
+		allUsers = append(allUsers, *response.Users...)
+		request.Page += 1
+	}
+	return allUsers, nil
+}
+
+func resourceRdsSqlserverAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and user from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<user>")
+	}
+	instanceId := parts[0]
+	dbUser := parts[1]
+
+	users, err := QuerySqlserverAccounts(client, instanceId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS SQL Server accounts")
+	}
+
+	for _, user := range users {
+		if user.Name == dbUser {
+			mErr := multierror.Append(nil,
+				d.Set("region", region),
+				d.Set("instance_id", instanceId),
+				d.Set("name", dbUser),
+			)
+			return diag.FromErr(mErr.ErrorOrNil())
+		}
+	}
+
+	d.SetId("")
+	log.Printf("[WARN] failed to fetch RDS SQL Server account %s: deleted", dbUser)
+	return nil
+}
+
+func resourceRdsSqlserverAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	// The passwords of MySQL and SQL Server accounts are reset through the same API.
+	updateOpts := &model.SetDbUserPwdRequest{
+		InstanceId: instanceId,
+		Body: &model.DbUserPwdRequest{
+			Name:     d.Get("name").(string),
+			Password: d.Get("password").(string),
+		},
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		_, err = client.SetDbUserPwd(updateOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error updating RDS SQL Server account: %s", err)
+	}
+
+	return resourceRdsSqlserverAccountRead(ctx, d, meta)
+}
+
+func resourceRdsSqlserverAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	deleteOpts := &model.DeleteSqlserverDbUserRequest{
+		InstanceId: instanceId,
+		UserName:   d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS SQL Server account options: %#v", deleteOpts)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err = client.DeleteSqlserverDbUser(deleteOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS SQL Server account: %s", err)
+	}
+
+	return nil
+}
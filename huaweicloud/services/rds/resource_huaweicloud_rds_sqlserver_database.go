@@ -0,0 +1,210 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func ResourceRdsSqlserverDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsSqlserverDatabaseCreate,
+		DeleteContext: resourceRdsSqlserverDatabaseDelete,
+		ReadContext:   resourceRdsSqlserverDatabaseRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRdsSqlserverDatabaseCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("name").(string)
+	createOpts := &model.CreateSqlserverDatabaseRequest{
+		InstanceId: instanceId,
+		Body: &model.SqlserverDatabaseForCreation{
+			Name: dbName,
+		},
+	}
+	log.Printf("[DEBUG] Create RDS SQL Server database options: %#v", createOpts)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err = client.CreateSqlserverDatabase(createOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS SQL Server database: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbName)
+	return resourceRdsSqlserverDatabaseRead(ctx, d, meta)
+}
+
+// QuerySqlserverDatabases returns the databases of the RDS SQL Server instance, all databases are returned if the
+// dbName is empty.
+func QuerySqlserverDatabases(client *v3.RdsClient, instanceId, dbName string) ([]model.SqlserverDatabaseForDetail,
+	error) {
+	request := &model.ListSqlserverDatabasesRequest{
+		InstanceId: instanceId,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+	if dbName != "" {
+		request.DbName = &dbName
+	}
+
+	allDatabases := make([]model.SqlserverDatabaseForDetail, 0)
+	for {
+		response, err := client.ListSqlserverDatabases(request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Databases == nil || len(*response.Databases) == 0 {
+			break
+		}
+		allDatabases = append(allDatabases, *response.Databases...)
+		request.Page += 1
+	}
+	return allDatabases, nil
+}
+
+func resourceRdsSqlserverDatabaseRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+
+	databases, err := QuerySqlserverDatabases(client, instanceId, dbName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS SQL Server databases")
+	}
+
+	for _, db := range databases {
+		if db.Name != dbName {
+			continue
+		}
+		mErr := multierror.Append(nil,
+			d.Set("region", region),
+			d.Set("instance_id", instanceId),
+			d.Set("name", dbName),
+			d.Set("character_set", db.CharacterSet),
+			d.Set("status", db.State),
+		)
+		if err = mErr.ErrorOrNil(); err != nil {
+			return diag.Errorf("error setting RDS SQL Server database fields: %s", err)
+		}
+		return nil
+	}
+
+	d.SetId("")
+	log.Printf("[WARN] failed to fetch RDS SQL Server database %s: deleted", dbName)
+	return nil
+}
+
+func resourceRdsSqlserverDatabaseDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	deleteOpts := &model.DeleteSqlserverDatabaseRequest{
+		InstanceId: instanceId,
+		DbName:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Delete RDS SQL Server database options: %#v", deleteOpts)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err = client.DeleteSqlserverDatabase(deleteOpts)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS SQL Server database: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package rds
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v3 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/rds/v3/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsSqlserverDatabasePrivilege() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRdsSqlserverDatabasePrivilegeCreate,
+		DeleteContext: resourceRdsSqlserverDatabasePrivilegeDelete,
+		ReadContext:   resourceRdsSqlserverDatabasePrivilegeRead,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						// The read-only users are the members of db_datareader role, others are the members of
+						// db_owner role.
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildSqlserverUserOpts(rawUsers []interface{}, withPrivilege bool) []model.SqlserverUserWithPrivilege {
+	usersOpts := make([]model.SqlserverUserWithPrivilege, len(rawUsers))
+	for i, v := range rawUsers {
+		user := v.(map[string]interface{})
+		usersOpts[i] = model.SqlserverUserWithPrivilege{
+			Name: user["name"].(string),
+		}
+		if withPrivilege {
+			usersOpts[i].Readonly = utils.Bool(user["readonly"].(bool))
+		}
+	}
+	return usersOpts
+}
+
+func resourceRdsSqlserverDatabasePrivilegeCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	dbName := d.Get("db_name").(string)
+	createOpts := model.SqlserverGrantRequest{
+		DbName: dbName,
+		Users:  buildSqlserverUserOpts(d.Get("users").(*schema.Set).List(), true),
+	}
+	log.Printf("[DEBUG] Create RDS SQL Server database privilege options: %#v", createOpts)
+
+	privilegeReq := model.AllowSqlserverDbUserPrivilegeRequest{
+		InstanceId: instanceId,
+		Body:       &createOpts,
+	}
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+	config.MutexKV.Lock(dbName)
+	defer config.MutexKV.Unlock(dbName)
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		_, err = client.AllowSqlserverDbUserPrivilege(&privilegeReq)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error creating RDS SQL Server database privilege: %s", err)
+	}
+
+	d.SetId(instanceId + "/" + dbName)
+	return resourceRdsSqlserverDatabasePrivilegeRead(ctx, d, meta)
+}
+
+// QuerySqlserverDatabaseUsers returns the users which are authorized to the RDS SQL Server database.
+func QuerySqlserverDatabaseUsers(client *v3.RdsClient, instanceId, dbName string) ([]model.UserWithPrivilege, error) {
+	request := model.ListAuthorizedSqlserverDbUsersRequest{
+		InstanceId: instanceId,
+		DbName:     dbName,
+		Limit:      int32(100),
+		Page:       int32(1),
+	}
+
+	allUsers := make([]model.UserWithPrivilege, 0)
+	for {
+		response, err := client.ListAuthorizedSqlserverDbUsers(&request)
+		if err != nil {
+			return nil, err
+		}
+		if response.Users == nil || len(*response.Users) == 0 {
+			break
+		}
+		allUsers = append(allUsers, *response.Users...)
+		request.Page += 1
+	}
+	return allUsers, nil
+}
+
+func resourceRdsSqlserverDatabasePrivilegeRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	region := c.GetRegion(d)
+	client, err := c.HcRdsV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	// Split instance_id and database from resource id
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return diag.Errorf("invalid id format, must be <instance_id>/<database_name>")
+	}
+	instanceId := parts[0]
+	dbName := parts[1]
+
+	users, err := QuerySqlserverDatabaseUsers(client, instanceId, dbName)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error listing RDS SQL Server database authorized users")
+	}
+	if len(users) == 0 {
+		d.SetId("")
+		log.Printf("[WARN] failed to fetch the authorized users of RDS SQL Server database %s: deleted", dbName)
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("db_name", dbName),
+		d.Set("users", flattenUsers(users)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting RDS SQL Server database privilege fields: %s", err)
+	}
+
+	return nil
+}
+
+func resourceRdsSqlserverDatabasePrivilegeDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	c := meta.(*config.Config)
+	client, err := c.HcRdsV3Client(c.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating RDS client: %s", err)
+	}
+
+	var (
+		instanceId = d.Get("instance_id").(string)
+		dbName     = d.Get("db_name").(string)
+		opts       = model.SqlserverRevokeRequest{
+			DbName: dbName,
+			Users:  buildSqlserverUserOpts(d.Get("users").(*schema.Set).List(), false),
+		}
+		deleteReq = model.RevokeSqlserverDbUserPrivilegeRequest{
+			InstanceId: instanceId,
+			Body:       &opts,
+		}
+	)
+	log.Printf("[DEBUG] Delete RDS SQL Server database privilege options: %#v", opts)
+
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+	config.MutexKV.Lock(dbName)
+	defer config.MutexKV.Unlock(dbName)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err = client.RevokeSqlserverDbUserPrivilege(&deleteReq)
+		retryable, err := handleMultiOperationsError(err)
+		if retryable {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("error deleting RDS SQL Server database privilege: %s", err)
+	}
+
+	return nil
+}
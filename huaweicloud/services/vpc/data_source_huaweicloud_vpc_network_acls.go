@@ -0,0 +1,215 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceNetworkAcls() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNetworkAclsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"network_acl_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the network ACL.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the network ACL.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the status of the network ACL.`,
+			},
+			"enabled": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies whether the network ACL is enabled, the value can be true or false.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the enterprise project ID of the network ACL.`,
+			},
+			"network_acls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enterprise_project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"associated_subnets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ingress_rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     networkAclRulesComputedSchema(),
+						},
+						"egress_rules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     networkAclRulesComputedSchema(),
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func networkAclRulesComputedSchema() *schema.Resource {
+	sc := networkAclRuleSchema()
+	for _, v := range sc.Schema {
+		v.Required = false
+		v.Optional = false
+		v.Default = nil
+		v.ValidateFunc = nil
+		v.Computed = true
+	}
+	return sc
+}
+
+func buildNetworkAclsQueryParams(d *schema.ResourceData) string {
+	res := ""
+	if v, ok := d.GetOk("network_acl_id"); ok {
+		res = fmt.Sprintf("%s&id=%v", res, v)
+	}
+	if v, ok := d.GetOk("name"); ok {
+		res = fmt.Sprintf("%s&name=%v", res, v)
+	}
+	if v, ok := d.GetOk("status"); ok {
+		res = fmt.Sprintf("%s&status=%v", res, v)
+	}
+	if v, ok := d.GetOk("enabled"); ok {
+		res = fmt.Sprintf("%s&admin_state_up=%v", res, v)
+	}
+	if v, ok := d.GetOk("enterprise_project_id"); ok {
+		res = fmt.Sprintf("%s&enterprise_project_id=%v", res, v)
+	}
+	return res
+}
+
+func dataSourceNetworkAclsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	listPath := buildNetworkAclPath(client, "", "") + "?limit=100" + buildNetworkAclsQueryParams(d)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+
+	acls := make([]interface{}, 0)
+	marker := ""
+	for {
+		currentPath := listPath
+		if marker != "" {
+			currentPath = fmt.Sprintf("%s&marker=%s", currentPath, marker)
+		}
+		listResp, err := client.Request("GET", currentPath, &listOpt)
+		if err != nil {
+			return diag.Errorf("error retrieving network ACLs: %s", err)
+		}
+
+		listRespBody, err := utils.FlattenResponse(listResp)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		acls = append(acls, utils.PathSearch("firewalls", listRespBody, make([]interface{}, 0)).([]interface{})...)
+
+		marker = utils.PathSearch("page_info.next_marker", listRespBody, "").(string)
+		if marker == "" {
+			break
+		}
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("network_acls", flattenNetworkAcls(acls)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenNetworkAcls(acls []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(acls))
+	for i, acl := range acls {
+		result[i] = map[string]interface{}{
+			"id":                    utils.PathSearch("id", acl, nil),
+			"name":                  utils.PathSearch("name", acl, nil),
+			"description":           utils.PathSearch("description", acl, nil),
+			"enterprise_project_id": utils.PathSearch("enterprise_project_id", acl, nil),
+			"enabled":               utils.PathSearch("admin_state_up", acl, nil),
+			"status":                utils.PathSearch("status", acl, nil),
+			"associated_subnets":    utils.PathSearch("associations[*].virsubnet_id", acl, nil),
+			"ingress_rules":         flattenNetworkAclRules(utils.PathSearch("ingress_rules", acl, nil)),
+			"egress_rules":          flattenNetworkAclRules(utils.PathSearch("egress_rules", acl, nil)),
+			"created_at":            utils.PathSearch("created_at", acl, nil),
+			"updated_at":            utils.PathSearch("updated_at", acl, nil),
+		}
+	}
+	return result
+}
@@ -0,0 +1,189 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceVpcNetworkInterfaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcNetworkInterfacesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"network_interface_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the network interface.`,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the network ID of the subnet to which the network interfaces belong.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the network interface.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the status of the network interface.`,
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the device to which the network interfaces are attached.`,
+			},
+			"mac_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the MAC address of the network interface.`,
+			},
+			"network_interfaces": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fixed_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secondary_private_ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source_dest_check": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"allowed_address_pairs": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mac_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVpcNetworkInterfacesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+
+	listOpts := ports.ListOpts{
+		ID:         d.Get("network_interface_id").(string),
+		NetworkID:  d.Get("subnet_id").(string),
+		Name:       d.Get("name").(string),
+		Status:     d.Get("status").(string),
+		DeviceID:   d.Get("device_id").(string),
+		MACAddress: d.Get("mac_address").(string),
+	}
+	allPages, err := ports.List(client, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("error retrieving network interfaces: %s", err)
+	}
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return diag.Errorf("error extracting network interfaces: %s", err)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("network_interfaces", flattenVpcNetworkInterfaces(allPorts)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenVpcNetworkInterfaces(allPorts []ports.Port) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(allPorts))
+	for i, port := range allPorts {
+		fixedIP, secondaryIPs := flattenNetworkInterfaceFixedIPs(port.FixedIPs, "")
+		addressPairs, sourceDestCheck := flattenNetworkInterfaceAddressPairs(port.AllowedAddressPairs)
+		result[i] = map[string]interface{}{
+			"id":                    port.ID,
+			"name":                  port.Name,
+			"subnet_id":             port.NetworkID,
+			"fixed_ip":              fixedIP,
+			"secondary_private_ips": secondaryIPs,
+			"security_group_ids":    port.SecurityGroups,
+			"source_dest_check":     sourceDestCheck,
+			"allowed_address_pairs": addressPairs,
+			"mac_address":           port.MACAddress,
+			"status":                port.Status,
+			"device_id":             port.DeviceID,
+			"device_owner":          port.DeviceOwner,
+		}
+	}
+	return result
+}
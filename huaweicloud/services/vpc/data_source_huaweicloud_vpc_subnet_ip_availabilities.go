@@ -0,0 +1,146 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/networking/v1/subnets"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/extensions/networkipavailabilities"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceVpcSubnetIpAvailabilities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcSubnetIpAvailabilitiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"vpc_id"},
+				Description:  `Specifies the network IDs of the subnets to query.`,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the VPC to which the subnets belong.`,
+			},
+			"ip_availabilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_ips": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"used_ips": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"available_ips": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getVpcSubnetIds(cfg *config.Config, region, vpcId string) ([]string, error) {
+	client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+
+	allSubnets, err := subnets.List(client, subnets.ListOpts{VPC_ID: vpcId})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(allSubnets))
+	for i, subnet := range allSubnets {
+		result[i] = subnet.ID
+	}
+	return result, nil
+}
+
+func dataSourceVpcSubnetIpAvailabilitiesRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+
+	networkIds := utils.ExpandToStringList(d.Get("subnet_ids").([]interface{}))
+	if vpcId, ok := d.GetOk("vpc_id"); ok {
+		networkIds, err = getVpcSubnetIds(cfg, region, vpcId.(string))
+		if err != nil {
+			return diag.Errorf("error retrieving subnets of VPC (%s): %s", vpcId, err)
+		}
+	}
+
+	result := make([]map[string]interface{}, 0, len(networkIds))
+	for _, networkId := range networkIds {
+		availability, err := networkipavailabilities.Get(client, networkId).Extract()
+		if err != nil {
+			return diag.Errorf("error retrieving IP availability of subnet (%s): %s", networkId, err)
+		}
+		// The IPv4 and IPv6 CIDRs of the subnet are returned respectively.
+		for _, v := range availability.SubnetIPAvailabilities {
+			result = append(result, map[string]interface{}{
+				"subnet_id":     networkId,
+				"subnet_name":   availability.NetworkName,
+				"cidr":          v.CIDR,
+				"ip_version":    v.IPVersion,
+				"total_ips":     v.TotalIPs,
+				"used_ips":      v.UsedIPs,
+				"available_ips": v.TotalIPs - v.UsedIPs,
+			})
+		}
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("ip_availabilities", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,128 @@
+package vpc
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func DataSourceVpcSubnetPrivateIps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVpcSubnetPrivateIpsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the network ID of the subnet to which the private IPs belong.`,
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the private IP address.`,
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the device which holds the private IPs.`,
+			},
+			"device_owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the owner of the device which holds the private IPs.`,
+			},
+			"private_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVpcSubnetPrivateIpsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+
+	networkId := d.Get("subnet_id").(string)
+	listOpts := ports.ListOpts{
+		NetworkID:   networkId,
+		DeviceID:    d.Get("device_id").(string),
+		DeviceOwner: d.Get("device_owner").(string),
+	}
+	allPages, err := ports.List(client, listOpts).AllPages()
+	if err != nil {
+		return diag.Errorf("error retrieving ports of subnet (%s): %s", networkId, err)
+	}
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return diag.Errorf("error extracting ports of subnet (%s): %s", networkId, err)
+	}
+
+	ipAddress := d.Get("ip_address").(string)
+	result := make([]map[string]interface{}, 0)
+	for _, port := range allPorts {
+		for _, fixedIP := range port.FixedIPs {
+			if ipAddress != "" && fixedIP.IPAddress != ipAddress {
+				continue
+			}
+			result = append(result, map[string]interface{}{
+				"ip_address":   fixedIP.IPAddress,
+				"port_id":      port.ID,
+				"device_id":    port.DeviceID,
+				"device_owner": port.DeviceOwner,
+				"mac_address":  port.MACAddress,
+				"status":       port.Status,
+			})
+		}
+	}
+
+	d.SetId(networkId)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("private_ips", result),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
@@ -0,0 +1,444 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	v3Groups "github.com/chnsz/golangsdk/openstack/networking/v3/security/groups"
+	v3Rules "github.com/chnsz/golangsdk/openstack/networking/v3/security/rules"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	secgroupRulesBatchCreateHttpUrl = "v3/{project_id}/vpc/security-groups/{security_group_id}/" +
+		"security-group-rules/batch-create"
+	secgroupRulesBatchDeleteHttpUrl = "v3/{project_id}/vpc/security-groups/{security_group_id}/" +
+		"security-group-rules/batch-delete"
+)
+
+// secgroupRuleKeys are the parameters which identify a security group rule, the rules with the same values are
+// regarded as the same rule.
+var secgroupRuleKeys = []string{
+	"direction", "ethertype", "protocol", "ports", "remote_ip_prefix", "remote_group_id", "remote_address_group_id",
+	"action", "priority", "description",
+}
+
+func ResourceNetworkingSecGroupRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkingSecGroupRulesCreate,
+		ReadContext:   resourceNetworkingSecGroupRulesRead,
+		UpdateContext: resourceNetworkingSecGroupRulesUpdate,
+		DeleteContext: resourceNetworkingSecGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the security group which the rules belong to.`,
+			},
+			"rules": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      resourceNetworkingSecGroupRuleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ingress", "egress",
+							}, false),
+							Description: `Specifies the direction of the rule.`,
+						},
+						"ethertype": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "IPv4",
+							ValidateFunc: validation.StringInSlice([]string{
+								"IPv4", "IPv6",
+							}, false),
+							Description: `Specifies the IP protocol version of the rule.`,
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.Any(
+								validation.StringInSlice([]string{"tcp", "udp", "icmp", "icmpv6"}, false),
+								validation.StringMatch(regexp.MustCompile("^([0-1]?[0-9]?[0-9]|2[0-4][0-9]|25[0-5])$"),
+									"The valid protocol is range from 0 to 255.",
+								),
+							),
+							Description: `Specifies the protocol type of the rule.`,
+						},
+						"ports": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the allowed port value range of the rule.`,
+						},
+						"remote_ip_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.Any(
+								utils.ValidateCIDR,
+								validation.IsIPAddress,
+							),
+							DiffSuppressFunc: func(_, o, n string, _ *schema.ResourceData) bool {
+								return normalizeSecgroupRuleCIDR(o) == normalizeSecgroupRuleCIDR(n)
+							},
+							Description: `Specifies the remote CIDR of the rule.`,
+						},
+						"remote_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the remote security group ID of the rule.`,
+						},
+						"remote_address_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the remote address group ID of the rule.`,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "allow",
+							ValidateFunc: validation.StringInSlice([]string{
+								"allow", "deny",
+							}, false),
+							Description: `Specifies the effective policy of the rule.`,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 100),
+							Description:  `Specifies the priority of the rule.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the description of the rule.`,
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The ID of the rule.`,
+						},
+					},
+				},
+				Description: `Specifies the complete rule set of the security group.`,
+			},
+		},
+	}
+}
+
+// normalizeSecgroupRuleCIDR returns the CIDR in the format returned by the API, e.g. a single IP address is returned
+// with the /32 or /128 prefix length, and the host bits and the IPv6 address are normalized.
+func normalizeSecgroupRuleCIDR(cidr string) string {
+	if cidr == "" {
+		return ""
+	}
+	if !strings.Contains(cidr, "/") {
+		ip := net.ParseIP(cidr)
+		if ip == nil {
+			return cidr
+		}
+		if ip.To4() != nil {
+			return ip.String() + "/32"
+		}
+		return ip.String() + "/128"
+	}
+
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidr
+	}
+	return ipNet.String()
+}
+
+// buildSecgroupRuleKey returns a string which identifies the rule, the computed ID is not included.
+func buildSecgroupRuleKey(rule map[string]interface{}) string {
+	values := make([]string, len(secgroupRuleKeys))
+	for i, key := range secgroupRuleKeys {
+		if key == "remote_ip_prefix" {
+			values[i] = normalizeSecgroupRuleCIDR(fmt.Sprint(rule[key]))
+			continue
+		}
+		values[i] = fmt.Sprint(rule[key])
+	}
+	return strings.ToLower(strings.Join(values, "|"))
+}
+
+func resourceNetworkingSecGroupRuleHash(v interface{}) int {
+	return hashcode.String(buildSecgroupRuleKey(v.(map[string]interface{})))
+}
+
+func flattenSecgroupRule(rule v3Rules.SecurityGroupRule) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                      rule.ID,
+		"direction":               rule.Direction,
+		"ethertype":               rule.Ethertype,
+		"protocol":                rule.Protocol,
+		"ports":                   rule.MultiPort,
+		"remote_ip_prefix":        rule.RemoteIpPrefix,
+		"remote_group_id":         rule.RemoteGroupId,
+		"remote_address_group_id": rule.RemoteAddressGroupId,
+		"action":                  rule.Action,
+		"priority":                rule.Priority,
+		"description":             rule.Description,
+	}
+}
+
+func listSecgroupRules(client *golangsdk.ServiceClient, securityGroupId string) ([]map[string]interface{}, error) {
+	rules, err := v3Rules.List(client, v3Rules.ListOpts{
+		SecurityGroupId: securityGroupId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		result[i] = flattenSecgroupRule(rule)
+	}
+	return result, nil
+}
+
+func buildSecgroupRulesCreateBodyParams(rules []map[string]interface{}) map[string]interface{} {
+	rulesParams := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		rulesParams[i] = utils.RemoveNil(map[string]interface{}{
+			"direction":               rule["direction"],
+			"ethertype":               rule["ethertype"],
+			"protocol":                utils.ValueIngoreEmpty(rule["protocol"]),
+			"multiport":               utils.ValueIngoreEmpty(rule["ports"]),
+			"remote_ip_prefix":        utils.ValueIngoreEmpty(rule["remote_ip_prefix"]),
+			"remote_group_id":         utils.ValueIngoreEmpty(rule["remote_group_id"]),
+			"remote_address_group_id": utils.ValueIngoreEmpty(rule["remote_address_group_id"]),
+			"action":                  rule["action"],
+			"priority":                rule["priority"],
+			"description":             utils.ValueIngoreEmpty(rule["description"]),
+		})
+	}
+	return map[string]interface{}{
+		"security_group_rules": rulesParams,
+	}
+}
+
+func batchCreateSecgroupRules(client *golangsdk.ServiceClient, securityGroupId string,
+	rules []map[string]interface{}) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	createPath := client.Endpoint + secgroupRulesBatchCreateHttpUrl
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createPath = strings.ReplaceAll(createPath, "{security_group_id}", securityGroupId)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+		JSONBody: buildSecgroupRulesCreateBodyParams(rules),
+	}
+	_, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return fmt.Errorf("error creating rules of security group (%s): %s", securityGroupId, err)
+	}
+	return nil
+}
+
+func batchDeleteSecgroupRules(client *golangsdk.ServiceClient, securityGroupId string, ruleIds []string) error {
+	if len(ruleIds) == 0 {
+		return nil
+	}
+
+	deletePath := client.Endpoint + secgroupRulesBatchDeleteHttpUrl
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{security_group_id}", securityGroupId)
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 204,
+		},
+		JSONBody: map[string]interface{}{
+			"security_group_rule_ids": ruleIds,
+		},
+	}
+	_, err := client.Request("POST", deletePath, &deleteOpt)
+	if err != nil {
+		return fmt.Errorf("error deleting rules (%s) of security group (%s): %s", strings.Join(ruleIds, ", "),
+			securityGroupId, err)
+	}
+	return nil
+}
+
+// reconcileSecgroupRules compares the rules of the security group with the specified rules, then deletes the rules
+// which are not specified and creates the missing rules.
+func reconcileSecgroupRules(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	securityGroupId := d.Get("security_group_id").(string)
+	existingRules, err := listSecgroupRules(client, securityGroupId)
+	if err != nil {
+		return fmt.Errorf("error retrieving rules of security group (%s): %s", securityGroupId, err)
+	}
+
+	specifiedRules := make(map[string]map[string]interface{})
+	for _, v := range d.Get("rules").(*schema.Set).List() {
+		rule := v.(map[string]interface{})
+		specifiedRules[buildSecgroupRuleKey(rule)] = rule
+	}
+
+	removedRuleIds := make([]string, 0)
+	for _, rule := range existingRules {
+		key := buildSecgroupRuleKey(rule)
+		if _, ok := specifiedRules[key]; ok {
+			delete(specifiedRules, key)
+			continue
+		}
+		removedRuleIds = append(removedRuleIds, rule["id"].(string))
+	}
+
+	addedRules := make([]map[string]interface{}, 0, len(specifiedRules))
+	for _, rule := range specifiedRules {
+		addedRules = append(addedRules, rule)
+	}
+
+	log.Printf("[DEBUG] Removing rules %v and adding %d rules for security group (%s)", removedRuleIds,
+		len(addedRules), securityGroupId)
+	if err = batchDeleteSecgroupRules(client, securityGroupId, removedRuleIds); err != nil {
+		return err
+	}
+	return batchCreateSecgroupRules(client, securityGroupId, addedRules)
+}
+
+func resourceNetworkingSecGroupRulesCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	if err = reconcileSecgroupRules(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("security_group_id").(string))
+	return resourceNetworkingSecGroupRulesRead(ctx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	// Listing the rules of a deleted security group returns an empty list, so check the security group first.
+	if _, err = v3Groups.Get(client, d.Id()); err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving security group")
+	}
+
+	rules, err := listSecgroupRules(client, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving security group rules: %s", err)
+	}
+
+	// Report the rules which are created out of Terraform, they are removed in the next apply.
+	var diags diag.Diagnostics
+	managedRules := d.Get("rules").(*schema.Set)
+	if managedRules.Len() > 0 {
+		unmanagedRuleIds := make([]string, 0)
+		for _, rule := range rules {
+			if !managedRules.Contains(rule) {
+				unmanagedRuleIds = append(unmanagedRuleIds, rule["id"].(string))
+			}
+		}
+		if len(unmanagedRuleIds) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Unmanaged security group rules found",
+				Detail: fmt.Sprintf("The rules (%s) of security group (%s) are not managed by Terraform, they will "+
+					"be removed in the next apply.", strings.Join(unmanagedRuleIds, ", "), d.Id()),
+			})
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("security_group_id", d.Id()),
+		d.Set("rules", rules),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
+func resourceNetworkingSecGroupRulesUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	if d.HasChange("rules") {
+		if err = reconcileSecgroupRules(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceNetworkingSecGroupRulesRead(ctx, d, meta)
+}
+
+func resourceNetworkingSecGroupRulesDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	rules, err := listSecgroupRules(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving security group rules")
+	}
+
+	ruleIds := make([]string, len(rules))
+	for i, rule := range rules {
+		ruleIds[i] = rule["id"].(string)
+	}
+	return diag.FromErr(batchDeleteSecgroupRules(client, d.Id(), ruleIds))
+}
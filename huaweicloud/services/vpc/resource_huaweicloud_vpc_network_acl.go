@@ -0,0 +1,490 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const networkAclHttpUrl = "v3/{project_id}/vpc/firewalls"
+
+func ResourceNetworkAcl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkAclCreate,
+		ReadContext:   resourceNetworkAclRead,
+		UpdateContext: resourceNetworkAclUpdate,
+		DeleteContext: resourceNetworkAclDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the network ACL.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the description of the network ACL.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the enterprise project ID of the network ACL.`,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Specifies whether to enable the network ACL.`,
+			},
+			"ingress_rules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        networkAclRuleSchema(),
+				Description: `Specifies the ordered ingress rules of the network ACL.`,
+			},
+			"egress_rules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        networkAclRuleSchema(),
+				Description: `Specifies the ordered egress rules of the network ACL.`,
+			},
+			"associated_subnets": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the IDs of the subnets associated with the network ACL.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the network ACL.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The creation time of the network ACL.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The latest update time of the network ACL.`,
+			},
+		},
+	}
+}
+
+func networkAclRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"allow", "deny",
+				}, false),
+				Description: `Specifies the action of the rule.`,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp", "icmp", "icmpv6", "any",
+				}, false),
+				Description: `Specifies the protocol of the rule.`,
+			},
+			"ip_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntInSlice([]int{4, 6}),
+				Description:  `Specifies the IP version of the rule.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the rule.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the description of the rule.`,
+			},
+			"source_ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the source IP address or CIDR of the rule.`,
+			},
+			"destination_ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the destination IP address or CIDR of the rule.`,
+			},
+			"source_port": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the source ports of the rule.`,
+			},
+			"destination_port": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the destination ports of the rule.`,
+			},
+			"source_address_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the source address group ID of the rule.`,
+			},
+			"destination_address_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the destination address group ID of the rule.`,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Specifies whether to enable the rule.`,
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the rule.`,
+			},
+		},
+	}
+}
+
+func buildNetworkAclPath(client *golangsdk.ServiceClient, id, action string) string {
+	path := client.Endpoint + networkAclHttpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	if id != "" {
+		path = path + "/" + id
+	}
+	if action != "" {
+		path = path + "/" + action
+	}
+	return path
+}
+
+func buildNetworkAclRuleParams(rule map[string]interface{}) map[string]interface{} {
+	return utils.RemoveNil(map[string]interface{}{
+		"id":                           utils.ValueIngoreEmpty(rule["rule_id"]),
+		"action":                       rule["action"],
+		"protocol":                     rule["protocol"],
+		"ip_version":                   rule["ip_version"],
+		"name":                         rule["name"],
+		"description":                  rule["description"],
+		"source_ip_address":            utils.ValueIngoreEmpty(rule["source_ip_address"]),
+		"destination_ip_address":       utils.ValueIngoreEmpty(rule["destination_ip_address"]),
+		"source_port":                  utils.ValueIngoreEmpty(rule["source_port"]),
+		"destination_port":             utils.ValueIngoreEmpty(rule["destination_port"]),
+		"source_address_group_id":      utils.ValueIngoreEmpty(rule["source_address_group_id"]),
+		"destination_address_group_id": utils.ValueIngoreEmpty(rule["destination_address_group_id"]),
+		"enabled":                      rule["enabled"],
+	})
+}
+
+func buildNetworkAclRulesParams(rules []interface{}) []map[string]interface{} {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(rules))
+	for i, v := range rules {
+		result[i] = buildNetworkAclRuleParams(v.(map[string]interface{}))
+	}
+	return result
+}
+
+func updateNetworkAclRules(client *golangsdk.ServiceClient, id, action string, params map[string]interface{}) error {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			"firewall": utils.RemoveNil(params),
+		},
+	}
+	_, err := client.Request("PUT", buildNetworkAclPath(client, id, action), &opt)
+	if err != nil {
+		return fmt.Errorf("error updating rules of network ACL (%s) by %s: %s", id, action, err)
+	}
+	return nil
+}
+
+func updateNetworkAclSubnets(client *golangsdk.ServiceClient, id, action string, subnetIds []interface{}) error {
+	if len(subnetIds) == 0 {
+		return nil
+	}
+
+	subnets := make([]map[string]interface{}, len(subnetIds))
+	for i, subnetId := range subnetIds {
+		subnets[i] = map[string]interface{}{
+			"virsubnet_id": subnetId,
+		}
+	}
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		JSONBody: map[string]interface{}{
+			"subnets": subnets,
+		},
+	}
+	_, err := client.Request("PUT", buildNetworkAclPath(client, id, action), &opt)
+	if err != nil {
+		return fmt.Errorf("error updating subnets of network ACL (%s) by %s: %s", id, action, err)
+	}
+	return nil
+}
+
+func resourceNetworkAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201,
+		},
+		JSONBody: map[string]interface{}{
+			"firewall": utils.RemoveNil(map[string]interface{}{
+				"name":                  d.Get("name"),
+				"description":           utils.ValueIngoreEmpty(d.Get("description")),
+				"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+				"admin_state_up":        d.Get("enabled"),
+			}),
+		},
+	}
+	createResp, err := client.Request("POST", buildNetworkAclPath(client, "", ""), &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating network ACL: %s", err)
+	}
+
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := utils.PathSearch("firewall.id", createRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the network ACL ID from the API response")
+	}
+	d.SetId(id)
+
+	ingressRules := buildNetworkAclRulesParams(d.Get("ingress_rules").([]interface{}))
+	egressRules := buildNetworkAclRulesParams(d.Get("egress_rules").([]interface{}))
+	if len(ingressRules) > 0 || len(egressRules) > 0 {
+		err = updateNetworkAclRules(client, id, "insert-rules", map[string]interface{}{
+			"ingress_rules": ingressRules,
+			"egress_rules":  egressRules,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = updateNetworkAclSubnets(client, id, "associate-subnets", d.Get("associated_subnets").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceNetworkAclRead(ctx, d, meta)
+}
+
+// GetNetworkAcl queries the network ACL by ID.
+func GetNetworkAcl(client *golangsdk.ServiceClient, id string) (interface{}, error) {
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", buildNetworkAclPath(client, id, ""), &getOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("firewall", getRespBody, nil), nil
+}
+
+func flattenNetworkAclRules(rules interface{}) []map[string]interface{} {
+	rulesRaw, _ := rules.([]interface{})
+	result := make([]map[string]interface{}, len(rulesRaw))
+	for i, v := range rulesRaw {
+		result[i] = map[string]interface{}{
+			"rule_id":                      utils.PathSearch("id", v, nil),
+			"action":                       utils.PathSearch("action", v, nil),
+			"protocol":                     utils.PathSearch("protocol", v, nil),
+			"ip_version":                   utils.PathSearch("ip_version", v, nil),
+			"name":                         utils.PathSearch("name", v, nil),
+			"description":                  utils.PathSearch("description", v, nil),
+			"source_ip_address":            utils.PathSearch("source_ip_address", v, nil),
+			"destination_ip_address":       utils.PathSearch("destination_ip_address", v, nil),
+			"source_port":                  utils.PathSearch("source_port", v, nil),
+			"destination_port":             utils.PathSearch("destination_port", v, nil),
+			"source_address_group_id":      utils.PathSearch("source_address_group_id", v, nil),
+			"destination_address_group_id": utils.PathSearch("destination_address_group_id", v, nil),
+			"enabled":                      utils.PathSearch("enabled", v, nil),
+		}
+	}
+	return result
+}
+
+func resourceNetworkAclRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	acl, err := GetNetworkAcl(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving network ACL")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", acl, nil)),
+		d.Set("description", utils.PathSearch("description", acl, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("enterprise_project_id", acl, nil)),
+		d.Set("enabled", utils.PathSearch("admin_state_up", acl, nil)),
+		d.Set("ingress_rules", flattenNetworkAclRules(utils.PathSearch("ingress_rules", acl, nil))),
+		d.Set("egress_rules", flattenNetworkAclRules(utils.PathSearch("egress_rules", acl, nil))),
+		d.Set("associated_subnets", utils.PathSearch("associations[*].virsubnet_id", acl, nil)),
+		d.Set("status", utils.PathSearch("status", acl, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", acl, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", acl, nil)),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// buildNetworkAclRulesUpdateParams returns the complete ordered rule list of the direction. The old and new rules are
+// compared by position, the rule at the same position keeps its ID and is updated in place, the rules without ID are
+// created, and the old rules which are not in the list are removed, so the order is always the same as the
+// configuration.
+func buildNetworkAclRulesUpdateParams(d *schema.ResourceData, key string) []map[string]interface{} {
+	oldRaw, newRaw := d.GetChange(key)
+	oldRules, newRules := oldRaw.([]interface{}), newRaw.([]interface{})
+
+	result := make([]map[string]interface{}, len(newRules))
+	for i, v := range newRules {
+		// Copy the rule, the map from the configuration must not be modified.
+		rule := make(map[string]interface{})
+		for k, val := range v.(map[string]interface{}) {
+			rule[k] = val
+		}
+		rule["rule_id"] = ""
+		if i < len(oldRules) {
+			rule["rule_id"] = oldRules[i].(map[string]interface{})["rule_id"]
+		}
+		result[i] = buildNetworkAclRuleParams(rule)
+	}
+	return result
+}
+
+func resourceNetworkAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	// The basic information and the complete rule lists of both directions are applied in one call.
+	if d.HasChanges("name", "description", "enabled", "ingress_rules", "egress_rules") {
+		params := map[string]interface{}{
+			"name":           d.Get("name"),
+			"description":    d.Get("description"),
+			"admin_state_up": d.Get("enabled"),
+		}
+		if d.HasChanges("ingress_rules", "egress_rules") {
+			params["ingress_rules"] = buildNetworkAclRulesUpdateParams(d, "ingress_rules")
+			params["egress_rules"] = buildNetworkAclRulesUpdateParams(d, "egress_rules")
+		}
+
+		updateOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+			JSONBody: map[string]interface{}{
+				"firewall": params,
+			},
+		}
+		_, err = client.Request("PUT", buildNetworkAclPath(client, d.Id(), ""), &updateOpt)
+		if err != nil {
+			return diag.Errorf("error updating network ACL (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("associated_subnets") {
+		oldRaw, newRaw := d.GetChange("associated_subnets")
+		oldSubnets, newSubnets := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		err = updateNetworkAclSubnets(client, d.Id(), "disassociate-subnets", oldSubnets.Difference(newSubnets).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = updateNetworkAclSubnets(client, d.Id(), "associate-subnets", newSubnets.Difference(oldSubnets).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceNetworkAclRead(ctx, d, meta)
+}
+
+func resourceNetworkAclDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV3Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v3 client: %s", err)
+	}
+
+	// The network ACL can not be deleted before the subnets are disassociated.
+	err = updateNetworkAclSubnets(client, d.Id(), "disassociate-subnets", d.Get("associated_subnets").(*schema.Set).List())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error disassociating subnets from network ACL")
+	}
+
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = client.Request("DELETE", buildNetworkAclPath(client, d.Id(), ""), &deleteOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting network ACL")
+	}
+	return nil
+}
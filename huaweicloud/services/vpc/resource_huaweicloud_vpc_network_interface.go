@@ -0,0 +1,348 @@
+package vpc
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/common/tags"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/subnets"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceVpcNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVpcNetworkInterfaceCreate,
+		ReadContext:   resourceVpcNetworkInterfaceRead,
+		UpdateContext: resourceVpcNetworkInterfaceUpdate,
+		DeleteContext: resourceVpcNetworkInterfaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the network ID of the subnet to which the network interface belongs.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the network interface.`,
+			},
+			"fixed_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the primary private IP address of the network interface.`,
+			},
+			"secondary_private_ips": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the secondary private IP addresses of the network interface.`,
+			},
+			"security_group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the IDs of the security groups bound to the network interface.`,
+			},
+			"source_dest_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Specifies whether to enable the source/destination check of the network interface.`,
+			},
+			"allowed_address_pairs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the IP address or CIDR of the allowed address pair.`,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Specifies the MAC address of the allowed address pair.`,
+						},
+					},
+				},
+				Description: `Specifies the allowed address pairs of the network interface.`,
+			},
+			"tags": common.TagsSchema(),
+			"mac_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The MAC address of the network interface.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the network interface.`,
+			},
+			"device_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the device to which the network interface is attached.`,
+			},
+			"device_owner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The owner of the device to which the network interface is attached.`,
+			},
+		},
+	}
+}
+
+// buildNetworkInterfaceFixedIPs builds the fixed IPs of the port, the primary IP is always the first one.
+func buildNetworkInterfaceFixedIPs(d *schema.ResourceData, subnetId string) []ports.IP {
+	result := []ports.IP{
+		{
+			SubnetID:  subnetId,
+			IPAddress: d.Get("fixed_ip").(string),
+		},
+	}
+	for _, ip := range d.Get("secondary_private_ips").(*schema.Set).List() {
+		result = append(result, ports.IP{
+			SubnetID:  subnetId,
+			IPAddress: ip.(string),
+		})
+	}
+	return result
+}
+
+func buildNetworkInterfaceAddressPairs(d *schema.ResourceData) []ports.AddressPair {
+	result := make([]ports.AddressPair, 0)
+	for _, v := range d.Get("allowed_address_pairs").(*schema.Set).List() {
+		pair := v.(map[string]interface{})
+		result = append(result, ports.AddressPair{
+			IPAddress:  pair["ip_address"].(string),
+			MACAddress: pair["mac_address"].(string),
+		})
+	}
+	if !d.Get("source_dest_check").(bool) {
+		result = append(result, ports.AddressPair{
+			IPAddress: common.SourceDestCheckDisabledAddress,
+		})
+	}
+	return result
+}
+
+func getNetworkInterfaceSubnetId(cfg *config.Config, region, networkId string) (string, error) {
+	client, err := cfg.NetworkingV1Client(region)
+	if err != nil {
+		return "", err
+	}
+
+	subnet, err := subnets.Get(client, networkId).Extract()
+	if err != nil {
+		return "", err
+	}
+	return subnet.SubnetId, nil
+}
+
+func resourceVpcNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+
+	networkId := d.Get("subnet_id").(string)
+	subnetId, err := getNetworkInterfaceSubnetId(cfg, region, networkId)
+	if err != nil {
+		return diag.Errorf("error retrieving subnet (%s): %s", networkId, err)
+	}
+
+	createOpts := ports.CreateOpts{
+		NetworkID:           networkId,
+		Name:                d.Get("name").(string),
+		FixedIPs:            buildNetworkInterfaceFixedIPs(d, subnetId),
+		AllowedAddressPairs: buildNetworkInterfaceAddressPairs(d),
+	}
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		securityGroupIds := utils.ExpandToStringListBySet(v.(*schema.Set))
+		createOpts.SecurityGroups = &securityGroupIds
+	}
+
+	log.Printf("[DEBUG] Create network interface options: %#v", createOpts)
+	port, err := ports.Create(client, createOpts).Extract()
+	if err != nil {
+		return diag.Errorf("error creating network interface: %s", err)
+	}
+	d.SetId(port.ID)
+
+	if tagRaw := d.Get("tags").(map[string]interface{}); len(tagRaw) > 0 {
+		tagList := utils.ExpandResourceTags(tagRaw)
+		if err = tags.Create(client, "ports", d.Id(), tagList).ExtractErr(); err != nil {
+			return diag.Errorf("error setting tags of network interface (%s): %s", d.Id(), err)
+		}
+	}
+	return resourceVpcNetworkInterfaceRead(ctx, d, meta)
+}
+
+// flattenNetworkInterfaceFixedIPs returns the primary IP and the secondary IPs of the port.
+func flattenNetworkInterfaceFixedIPs(fixedIPs []ports.IP, primaryIP string) (string, []string) {
+	if len(fixedIPs) == 0 {
+		return "", nil
+	}
+
+	primaryIndex := 0
+	for i, ip := range fixedIPs {
+		if ip.IPAddress == primaryIP {
+			primaryIndex = i
+			break
+		}
+	}
+
+	secondaryIPs := make([]string, 0, len(fixedIPs)-1)
+	for i, ip := range fixedIPs {
+		if i != primaryIndex {
+			secondaryIPs = append(secondaryIPs, ip.IPAddress)
+		}
+	}
+	return fixedIPs[primaryIndex].IPAddress, secondaryIPs
+}
+
+// flattenNetworkInterfaceAddressPairs returns the allowed address pairs and whether the source/destination check is
+// enabled.
+func flattenNetworkInterfaceAddressPairs(pairs []ports.AddressPair) ([]map[string]interface{}, bool) {
+	sourceDestCheck := true
+	result := make([]map[string]interface{}, 0, len(pairs))
+	for _, pair := range pairs {
+		if pair.IPAddress == common.SourceDestCheckDisabledAddress {
+			sourceDestCheck = false
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"ip_address":  pair.IPAddress,
+			"mac_address": pair.MACAddress,
+		})
+	}
+	return result, sourceDestCheck
+}
+
+// GetNetworkInterface queries the network interface by ID.
+func GetNetworkInterface(client *golangsdk.ServiceClient, id string) (*ports.Port, error) {
+	return ports.Get(client, id).Extract()
+}
+
+func resourceVpcNetworkInterfaceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+
+	port, err := GetNetworkInterface(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving network interface")
+	}
+
+	fixedIP, secondaryIPs := flattenNetworkInterfaceFixedIPs(port.FixedIPs, d.Get("fixed_ip").(string))
+	addressPairs, sourceDestCheck := flattenNetworkInterfaceAddressPairs(port.AllowedAddressPairs)
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("subnet_id", port.NetworkID),
+		d.Set("name", port.Name),
+		d.Set("fixed_ip", fixedIP),
+		d.Set("secondary_private_ips", secondaryIPs),
+		d.Set("security_group_ids", port.SecurityGroups),
+		d.Set("source_dest_check", sourceDestCheck),
+		d.Set("allowed_address_pairs", addressPairs),
+		d.Set("mac_address", port.MACAddress),
+		d.Set("status", port.Status),
+		d.Set("device_id", port.DeviceID),
+		d.Set("device_owner", port.DeviceOwner),
+	)
+
+	if resourceTags, err := tags.Get(client, "ports", d.Id()).Extract(); err == nil {
+		mErr = multierror.Append(mErr, d.Set("tags", utils.TagsToMap(resourceTags.Tags)))
+	} else {
+		log.Printf("[WARN] Error fetching tags of network interface (%s): %s", d.Id(), err)
+	}
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceVpcNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NetworkingV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+
+	if d.HasChanges("name", "secondary_private_ips", "security_group_ids", "source_dest_check",
+		"allowed_address_pairs") {
+		var updateOpts ports.UpdateOpts
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("secondary_private_ips") {
+			networkId := d.Get("subnet_id").(string)
+			subnetId, err := getNetworkInterfaceSubnetId(cfg, region, networkId)
+			if err != nil {
+				return diag.Errorf("error retrieving subnet (%s): %s", networkId, err)
+			}
+			updateOpts.FixedIPs = buildNetworkInterfaceFixedIPs(d, subnetId)
+		}
+		if d.HasChange("security_group_ids") {
+			securityGroupIds := utils.ExpandToStringListBySet(d.Get("security_group_ids").(*schema.Set))
+			updateOpts.SecurityGroups = &securityGroupIds
+		}
+		if d.HasChanges("source_dest_check", "allowed_address_pairs") {
+			addressPairs := buildNetworkInterfaceAddressPairs(d)
+			updateOpts.AllowedAddressPairs = &addressPairs
+		}
+
+		log.Printf("[DEBUG] Update network interface options: %#v", updateOpts)
+		if _, err = ports.Update(client, d.Id(), updateOpts).Extract(); err != nil {
+			return diag.Errorf("error updating network interface (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err = utils.UpdateResourceTags(client, d, "ports", d.Id()); err != nil {
+			return diag.Errorf("error updating tags of network interface (%s): %s", d.Id(), err)
+		}
+	}
+	return resourceVpcNetworkInterfaceRead(ctx, d, meta)
+}
+
+func resourceVpcNetworkInterfaceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NetworkingV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating VPC v2.0 client: %s", err)
+	}
+
+	if err = ports.Delete(client, d.Id()).ExtractErr(); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting network interface")
+	}
+	return nil
+}